@@ -347,19 +347,19 @@ func main() {
 			}
 		}
 
+		crioServer, err := server.New(ctx, config)
+		if err != nil {
+			logrus.Fatal(err)
+		}
+
 		grpcServer := grpc.NewServer(
-			grpc.UnaryInterceptor(interceptors.UnaryInterceptor()),
+			grpc.UnaryInterceptor(interceptors.UnaryInterceptor(crioServer.OperationLabels)),
 			grpc.StreamInterceptor(interceptors.StreamInterceptor()),
 			grpc.StatsHandler(otelgrpc.NewServerHandler(opts...)),
 			grpc.MaxSendMsgSize(config.GRPCMaxSendMsgSize),
 			grpc.MaxRecvMsgSize(config.GRPCMaxRecvMsgSize),
 		)
 
-		crioServer, err := server.New(ctx, config)
-		if err != nil {
-			logrus.Fatal(err)
-		}
-
 		// Immediately upon start up, write our new version files
 		// we write one to a tmpfs, so we can detect when a node rebooted.
 		if err := info.WriteVersionFile(config.VersionFile); err != nil {
@@ -2,7 +2,7 @@
 
 function __fish_crio_no_subcommand --description 'Test if there has been any subcommand yet'
     for i in (commandline -opc)
-        if contains -- $i check complete completion help h config man markdown md status config c containers container cs s info i goroutines g heap hp version wipe help h
+        if contains -- $i check complete completion help h config man markdown md status config c containers container cs s info i goroutines g heap hp sessions ss pressure p hostports hostport network n version wipe help h
             return 1
         end
     end
@@ -15,6 +15,7 @@ complete -c crio -n '__fish_crio_no_subcommand' -f -l additional-devices -r -d '
 complete -c crio -n '__fish_crio_no_subcommand' -f -l allowed-devices -r -d 'Devices a user is allowed to specify with the "io.kubernetes.cri-o.Devices" allowed annotation.'
 complete -c crio -n '__fish_crio_no_subcommand' -f -l apparmor-profile -r -d 'Name of the apparmor profile to be used as the runtime\'s default. This only takes effect if the user does not specify a profile via the Kubernetes Pod\'s metadata annotation.'
 complete -c crio -n '__fish_crio_no_subcommand' -f -l auto-reload-registries -d 'If true, CRI-O will automatically reload the mirror registry when there is an update to the \'registries.conf.d\' directory. Default value is set to \'false\'.'
+complete -c crio -n '__fish_crio_no_subcommand' -f -l bandwidth-shaping -r -d 'The way the pod bandwidth annotations are applied: \'cni\' passes them to the CNI bandwidth plugin, \'native\' shapes the traffic by using tc.'
 complete -c crio -n '__fish_crio_no_subcommand' -f -l big-files-temporary-dir -r -d 'Path to the temporary directory to use for storing big files, used to store image blobs and data streams related to containers image management.'
 complete -c crio -n '__fish_crio_no_subcommand' -f -l bind-mount-prefix -r -d 'A prefix to use for the source of the bind mounts. This option would be useful if you were running CRI-O in a container. And had \'/\' mounted on \'/host\' in your container. Then if you ran CRI-O with the \'--bind-mount-prefix=/host\' option, CRI-O would add /host to any bind mounts it is handed over CRI. If Kubernetes asked to have \'/var/lib/foobar\' bind mounted into the container, then CRI-O would bind mount \'/host/var/lib/foobar\'. Since CRI-O itself is running in a container with \'/\' or the host mounted on \'/host\', the container would end up with \'/var/lib/foobar\' from the host mounted in the container rather then \'/var/lib/foobar\' from the CRI-O container.'
 complete -c crio -n '__fish_crio_no_subcommand' -f -l blockio-config-file -r -d 'Path to the blockio class configuration file for configuring the cgroup blockio controller.'
@@ -84,6 +85,7 @@ complete -c crio -n '__fish_crio_no_subcommand' -f -l hooks-dir -r -d 'Set the O
     Kubernetes configuration are considered. Bind mounts that CRI-O
     inserts by default (e.g. \'/dev/shm\') are not considered.'
 complete -c crio -n '__fish_crio_no_subcommand' -f -l hostnetwork-disable-selinux -d 'Determines whether SELinux should be disabled within a pod when it is running in the host network namespace.'
+complete -c crio -n '__fish_crio_no_subcommand' -f -l hostport-ipv6-direct-routing -d 'If true, CRI-O reserves the hostports of IPv6 pods without installing NAT66 rules, because the pod addresses are routed directly.'
 complete -c crio -n '__fish_crio_no_subcommand' -f -l image-volumes -r -d 'Image volume handling (\'mkdir\', \'bind\', or \'ignore\')
     1. mkdir: A directory is created inside the container root filesystem for
        the volumes.
@@ -125,6 +127,7 @@ complete -c crio -n '__fish_crio_no_subcommand' -f -l metrics-socket -r -d 'Sock
 complete -c crio -n '__fish_crio_no_subcommand' -f -l minimum-mappable-gid -r -d 'Specify the lowest host GID which can be specified in mappings for a pod that will be run as a UID other than 0. This option is deprecated, and will be replaced with Kubernetes user namespace support (KEP-127) in the future.'
 complete -c crio -n '__fish_crio_no_subcommand' -f -l minimum-mappable-uid -r -d 'Specify the lowest host UID which can be specified in mappings for a pod that will be run as a UID other than 0. This option is deprecated, and will be replaced with Kubernetes user namespace support (KEP-127) in the future.'
 complete -c crio -n '__fish_crio_no_subcommand' -f -l namespaces-dir -r -d 'The directory where the state of the managed namespaces gets tracked. Only used when manage-ns-lifecycle is true.'
+complete -c crio -n '__fish_crio_no_subcommand' -f -l network-backend -r -d 'Backend used to set up the pod network (\'cni\' or \'static\').'
 complete -c crio -n '__fish_crio_no_subcommand' -f -l no-pivot -d 'If true, the runtime will not use \'pivot_root\', but instead use \'MS_MOVE\'.'
 complete -c crio -n '__fish_crio_no_subcommand' -f -l nri-disable-connections -d 'Disable connections from externally started NRI plugins.'
 complete -c crio -n '__fish_crio_no_subcommand' -f -l nri-enable-default-validator -d 'Enable the default NRI validator plugin.'
@@ -165,6 +168,9 @@ complete -c crio -n '__fish_crio_no_subcommand' -f -l shared-cpuset -r -d 'CPUs
 complete -c crio -n '__fish_crio_no_subcommand' -f -l short-name-mode -r -d 'Describes the mode of short name resolution. Allowed values are \'enforcing\' and \'disabled\'.'
 complete -c crio -n '__fish_crio_no_subcommand' -l signature-policy -r -d 'Path to signature policy JSON file.'
 complete -c crio -n '__fish_crio_no_subcommand' -l signature-policy-dir -r -d 'Path to the root directory for namespaced signature policies. Must be an absolute path.'
+complete -c crio -n '__fish_crio_no_subcommand' -f -l static-network-bridge -r -d 'Bridge the static network backend attaches the pods to.'
+complete -c crio -n '__fish_crio_no_subcommand' -f -l static-network-subnets -r -d 'Subnets the static network backend assigns the pod addresses from, at most one per IP family.'
+complete -c crio -n '__fish_crio_no_subcommand' -f -l stats-cache-ttl -r -d 'The number of seconds collected pod/container stats and pod sandbox metrics are cached per sandbox and container. If set to a value greater than 0, the stats are collected on-demand only and the collection period is ignored.'
 complete -c crio -n '__fish_crio_no_subcommand' -f -l stats-collection-period -r -d 'The number of seconds between collecting pod and container stats. If set to 0, the stats are collected on-demand instead. DEPRECATED: This option will be removed in the future.'
 complete -c crio -n '__fish_crio_no_subcommand' -f -l storage-driver -s s -r -d 'OCI storage driver.'
 complete -c crio -n '__fish_crio_no_subcommand' -f -l storage-opt -r -d 'OCI storage driver option.'
@@ -241,6 +247,15 @@ complete -r -c crio -n '__fish_seen_subcommand_from status' -a 'goroutines g' -d
 complete -c crio -n '__fish_seen_subcommand_from heap hp' -f -l help -s h -d 'show help'
 complete -r -c crio -n '__fish_seen_subcommand_from status' -a 'heap hp' -d 'Write the heap dump to a temp file and print its location on disk.'
 complete -c crio -n '__fish_seen_subcommand_from heap hp' -l file -s f -r -d 'Output file of the heap dump.'
+complete -c crio -n '__fish_seen_subcommand_from sessions ss' -f -l help -s h -d 'show help'
+complete -r -c crio -n '__fish_seen_subcommand_from status' -a 'sessions ss' -d 'Display the active exec and attach sessions or terminate one of them.'
+complete -c crio -n '__fish_seen_subcommand_from sessions ss' -f -l terminate -s t -r -d 'the ID of the session to terminate'
+complete -c crio -n '__fish_seen_subcommand_from pressure p' -f -l help -s h -d 'show help'
+complete -r -c crio -n '__fish_seen_subcommand_from status' -a 'pressure p' -d 'Follow the events of containers crossing the configured pressure stall thresholds.'
+complete -c crio -n '__fish_seen_subcommand_from hostports hostport' -f -l help -s h -d 'show help'
+complete -r -c crio -n '__fish_seen_subcommand_from status' -a 'hostports hostport' -d 'Display the host ports reserved by pod sandboxes.'
+complete -c crio -n '__fish_seen_subcommand_from network n' -f -l help -s h -d 'show help'
+complete -r -c crio -n '__fish_seen_subcommand_from status' -a 'network n' -d 'Display diagnostic information about the pod network, such as the discovered CNI configuration files and plugin binaries.'
 complete -c crio -n '__fish_seen_subcommand_from version' -f -l help -s h -d 'show help'
 complete -r -c crio -n '__fish_crio_no_subcommand' -a 'version' -d 'display detailed version information'
 complete -c crio -n '__fish_seen_subcommand_from version' -f -l json -s j -d 'print JSON instead of text'
@@ -0,0 +1,274 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/cri-o/cri-o/internal/log"
+)
+
+// Journald is the audit log target value for logging to the systemd journal.
+const Journald = "journald"
+
+// Type is the kind of an audited streaming operation.
+type Type string
+
+const (
+	// TypeExec is an audited exec session.
+	TypeExec Type = "exec"
+
+	// TypeAttach is an audited attach session.
+	TypeAttach Type = "attach"
+
+	// TypePortForward is an audited port forward session.
+	TypePortForward Type = "port-forward"
+)
+
+// Phase is the point in the lifetime of a session an event refers to.
+type Phase string
+
+const (
+	// PhaseStart is used for events emitted when a session begins.
+	PhaseStart Phase = "start"
+
+	// PhaseEnd is used for events emitted after a session finished.
+	PhaseEnd Phase = "end"
+)
+
+// Event is a single audit record.
+type Event struct {
+	Time               time.Time `json:"time"`
+	Type               Type      `json:"type"`
+	Phase              Phase     `json:"phase"`
+	SessionID          string    `json:"session_id,omitempty"`
+	Client             string    `json:"client,omitempty"`
+	PodSandboxID       string    `json:"pod_sandbox_id,omitempty"`
+	PodName            string    `json:"pod_name,omitempty"`
+	PodNamespace       string    `json:"pod_namespace,omitempty"`
+	PodUID             string    `json:"pod_uid,omitempty"`
+	ContainerID        string    `json:"container_id,omitempty"`
+	ContainerName      string    `json:"container_name,omitempty"`
+	Command            []string  `json:"command,omitempty"`
+	TTY                bool      `json:"tty"`
+	Port               int32     `json:"port,omitempty"`
+	DurationSeconds    float64   `json:"duration_seconds,omitempty"`
+	ExitCode           *int      `json:"exit_code,omitempty"`
+	Error              string    `json:"error,omitempty"`
+	Recording          string    `json:"recording,omitempty"`
+	RecordingTruncated bool      `json:"recording_truncated,omitempty"`
+}
+
+// Sink is the destination of audit events.
+type Sink interface {
+	Write(*Event) error
+	Close() error
+}
+
+// Logger writes audit events to a sink and optionally records the terminal
+// output of sessions. A nil Logger is valid and discards everything.
+type Logger struct {
+	sink             Sink
+	recordingDir     string
+	recordingMaxSize int64
+}
+
+// New creates a new audit logger for the provided target, which is either
+// Journald or an absolute path to a JSON lines file. Terminal output gets
+// recorded into recordingDir, up to recordingMaxSize bytes per session, if the
+// directory is not empty. New returns a nil Logger if the target is empty.
+func New(target, recordingDir string, recordingMaxSize int64) (*Logger, error) {
+	if target == "" {
+		return nil, nil
+	}
+
+	var (
+		sink Sink
+		err  error
+	)
+
+	if target == Journald {
+		sink, err = newJournaldSink()
+	} else {
+		sink, err = newFileSink(target)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("create audit log sink %s: %w", target, err)
+	}
+
+	if recordingDir != "" {
+		if err := os.MkdirAll(recordingDir, 0o700); err != nil {
+			sink.Close()
+
+			return nil, fmt.Errorf("create audit recording directory: %w", err)
+		}
+	}
+
+	return &Logger{
+		sink:             sink,
+		recordingDir:     recordingDir,
+		recordingMaxSize: recordingMaxSize,
+	}, nil
+}
+
+// Log writes the event to the audit sink. Failures are logged but not
+// returned, because auditing must not break the audited operation.
+func (l *Logger) Log(ctx context.Context, event *Event) {
+	if l == nil {
+		return
+	}
+
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+
+	if err := l.sink.Write(event); err != nil {
+		log.Errorf(ctx, "Unable to write %s audit event for session %s: %v", event.Type, event.SessionID, err)
+	}
+}
+
+// Close closes the underlying audit sink.
+func (l *Logger) Close() error {
+	if l == nil {
+		return nil
+	}
+
+	return l.sink.Close()
+}
+
+// Record returns a writer which copies everything written to w into a
+// recording file for the provided session. The returned recording has to be
+// finished after the session ended. If recording is disabled, w and a nil
+// recording are returned.
+func (l *Logger) Record(ctx context.Context, sessionID string, w io.WriteCloser) (io.WriteCloser, *Recording) {
+	if l == nil || l.recordingDir == "" || w == nil {
+		return w, nil
+	}
+
+	path := filepath.Join(l.recordingDir, sessionID+".log")
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		log.Errorf(ctx, "Unable to create audit recording for session %s: %v", sessionID, err)
+
+		return w, nil
+	}
+
+	r := &Recording{file: f, remaining: l.recordingMaxSize}
+
+	return &recordingWriter{WriteCloser: w, recording: r}, r
+}
+
+// Recording is the capped terminal output recording of a single session.
+type Recording struct {
+	mu        sync.Mutex
+	file      *os.File
+	remaining int64
+	truncated bool
+	closed    bool
+}
+
+// Finish closes the recording and stores its location into the event.
+func (r *Recording) Finish(event *Event) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.closed {
+		r.closed = true
+		r.file.Close()
+	}
+
+	event.Recording = r.file.Name()
+	event.RecordingTruncated = r.truncated
+}
+
+func (r *Recording) write(p []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed || r.truncated {
+		return
+	}
+
+	if int64(len(p)) > r.remaining {
+		p = p[:r.remaining]
+		r.truncated = true
+	}
+
+	n, err := r.file.Write(p)
+	r.remaining -= int64(n)
+
+	if err != nil {
+		// Stop recording on the first error, the session itself continues.
+		r.truncated = true
+	}
+}
+
+type recordingWriter struct {
+	io.WriteCloser
+	recording *Recording
+}
+
+func (w *recordingWriter) Write(p []byte) (int, error) {
+	n, err := w.WriteCloser.Write(p)
+	if n > 0 {
+		w.recording.write(p[:n])
+	}
+
+	return n, err
+}
+
+type fileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func newFileSink(path string) (*fileSink, error) {
+	if !filepath.IsAbs(path) {
+		return nil, errors.New("path has to be absolute")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileSink{file: f}, nil
+}
+
+func (s *fileSink) Write(event *Event) error {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal audit event: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, err = s.file.Write(append(b, '\n'))
+
+	return err
+}
+
+func (s *fileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.file.Close()
+}
@@ -0,0 +1,153 @@
+package audit_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cri-o/cri-o/internal/audit"
+)
+
+type nopWriteCloser struct{}
+
+func (nopWriteCloser) Write(p []byte) (int, error) { return len(p), nil }
+
+func (nopWriteCloser) Close() error { return nil }
+
+// The actual test suite.
+var _ = t.Describe("Audit", func() {
+	var (
+		logPath      string
+		recordingDir string
+	)
+
+	BeforeEach(func() {
+		dir := t.MustTempDir("audit")
+		logPath = filepath.Join(dir, "audit.log")
+		recordingDir = filepath.Join(dir, "recordings")
+	})
+
+	readEvents := func() []audit.Event {
+		f, err := os.Open(logPath)
+		Expect(err).NotTo(HaveOccurred())
+
+		defer f.Close()
+
+		events := []audit.Event{}
+		scanner := bufio.NewScanner(f)
+
+		for scanner.Scan() {
+			event := audit.Event{}
+			Expect(json.Unmarshal(scanner.Bytes(), &event)).To(Succeed())
+			events = append(events, event)
+		}
+
+		return events
+	}
+
+	t.Describe("New", func() {
+		It("should be disabled without target", func() {
+			// When
+			sut, err := audit.New("", "", 0)
+
+			// Then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sut).To(BeNil())
+			sut.Log(context.Background(), &audit.Event{})
+			Expect(sut.Close()).To(Succeed())
+		})
+
+		It("should fail with relative path", func() {
+			// When
+			sut, err := audit.New("audit.log", "", 0)
+
+			// Then
+			Expect(err).To(HaveOccurred())
+			Expect(sut).To(BeNil())
+		})
+	})
+
+	t.Describe("Log", func() {
+		It("should write JSON lines", func() {
+			// Given
+			sut, err := audit.New(logPath, "", 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			exitCode := 1
+
+			// When
+			sut.Log(context.Background(), &audit.Event{
+				Type:        audit.TypeExec,
+				Phase:       audit.PhaseStart,
+				ContainerID: "container",
+				Command:     []string{"sh", "-c", "exit 1"},
+			})
+			sut.Log(context.Background(), &audit.Event{
+				Type:     audit.TypeExec,
+				Phase:    audit.PhaseEnd,
+				ExitCode: &exitCode,
+			})
+			Expect(sut.Close()).To(Succeed())
+
+			// Then
+			events := readEvents()
+			Expect(events).To(HaveLen(2))
+			Expect(events[0].Phase).To(Equal(audit.PhaseStart))
+			Expect(events[0].ContainerID).To(Equal("container"))
+			Expect(events[0].Command).To(Equal([]string{"sh", "-c", "exit 1"}))
+			Expect(events[0].Time).NotTo(BeZero())
+			Expect(events[1].Phase).To(Equal(audit.PhaseEnd))
+			Expect(*events[1].ExitCode).To(Equal(1))
+		})
+	})
+
+	t.Describe("Record", func() {
+		It("should cap the recording size", func() {
+			// Given
+			sut, err := audit.New(logPath, recordingDir, 4)
+			Expect(err).NotTo(HaveOccurred())
+
+			defer sut.Close()
+
+			w, recording := sut.Record(context.Background(), "session", nopWriteCloser{})
+			Expect(recording).NotTo(BeNil())
+
+			// When
+			n, err := w.Write([]byte("hello world"))
+			event := &audit.Event{}
+			recording.Finish(event)
+
+			// Then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(n).To(Equal(11))
+			Expect(event.Recording).To(Equal(filepath.Join(recordingDir, "session.log")))
+			Expect(event.RecordingTruncated).To(BeTrue())
+
+			content, err := os.ReadFile(event.Recording)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal("hell"))
+		})
+
+		It("should not record without directory", func() {
+			// Given
+			sut, err := audit.New(logPath, "", 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			defer sut.Close()
+
+			stream := nopWriteCloser{}
+
+			// When
+			w, recording := sut.Record(context.Background(), "session", stream)
+
+			// Then
+			Expect(recording).To(BeNil())
+			Expect(w).To(Equal(stream))
+		})
+	})
+})
@@ -0,0 +1,110 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// journaldSocket is the native protocol socket of systemd-journald.
+const journaldSocket = "/run/systemd/journal/socket"
+
+// journaldSink writes audit events using the native journald protocol, see
+// https://systemd.io/JOURNAL_NATIVE_PROTOCOL.
+type journaldSink struct {
+	conn *net.UnixConn
+}
+
+func newJournaldSink() (*journaldSink, error) {
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: journaldSocket, Net: "unixgram"})
+	if err != nil {
+		return nil, err
+	}
+
+	return &journaldSink{conn: conn}, nil
+}
+
+func (s *journaldSink) Write(event *Event) error {
+	_, err := s.conn.Write(journaldMessage(event))
+
+	return err
+}
+
+func (s *journaldSink) Close() error {
+	return s.conn.Close()
+}
+
+// journaldMessage serializes the event into a native journald datagram.
+func journaldMessage(event *Event) []byte {
+	buf := &bytes.Buffer{}
+
+	message := fmt.Sprintf("%s %s", event.Type, event.Phase)
+	if event.ContainerID != "" {
+		message += " for container " + event.ContainerID
+	} else if event.PodSandboxID != "" {
+		message += " for pod sandbox " + event.PodSandboxID
+	}
+
+	writeJournaldField(buf, "MESSAGE", message)
+	writeJournaldField(buf, "SYSLOG_IDENTIFIER", "crio-audit")
+	writeJournaldField(buf, "CRIO_AUDIT_TYPE", string(event.Type))
+	writeJournaldField(buf, "CRIO_AUDIT_PHASE", string(event.Phase))
+	writeJournaldField(buf, "CRIO_AUDIT_TIME", event.Time.UTC().Format("2006-01-02T15:04:05.000000000Z"))
+	writeJournaldField(buf, "CRIO_AUDIT_SESSION_ID", event.SessionID)
+	writeJournaldField(buf, "CRIO_AUDIT_CLIENT", event.Client)
+	writeJournaldField(buf, "CRIO_AUDIT_POD_SANDBOX_ID", event.PodSandboxID)
+	writeJournaldField(buf, "CRIO_AUDIT_POD_NAME", event.PodName)
+	writeJournaldField(buf, "CRIO_AUDIT_POD_NAMESPACE", event.PodNamespace)
+	writeJournaldField(buf, "CRIO_AUDIT_POD_UID", event.PodUID)
+	writeJournaldField(buf, "CRIO_AUDIT_CONTAINER_ID", event.ContainerID)
+	writeJournaldField(buf, "CRIO_AUDIT_CONTAINER_NAME", event.ContainerName)
+	writeJournaldField(buf, "CRIO_AUDIT_COMMAND", strings.Join(event.Command, " "))
+	writeJournaldField(buf, "CRIO_AUDIT_TTY", strconv.FormatBool(event.TTY))
+
+	if event.Port != 0 {
+		writeJournaldField(buf, "CRIO_AUDIT_PORT", strconv.Itoa(int(event.Port)))
+	}
+
+	if event.Phase == PhaseEnd {
+		writeJournaldField(buf, "CRIO_AUDIT_DURATION_SECONDS", strconv.FormatFloat(event.DurationSeconds, 'f', -1, 64))
+	}
+
+	if event.ExitCode != nil {
+		writeJournaldField(buf, "CRIO_AUDIT_EXIT_CODE", strconv.Itoa(*event.ExitCode))
+	}
+
+	writeJournaldField(buf, "CRIO_AUDIT_ERROR", event.Error)
+	writeJournaldField(buf, "CRIO_AUDIT_RECORDING", event.Recording)
+
+	if event.RecordingTruncated {
+		writeJournaldField(buf, "CRIO_AUDIT_RECORDING_TRUNCATED", "true")
+	}
+
+	return buf.Bytes()
+}
+
+// writeJournaldField appends a single field to the datagram. Empty values are
+// skipped, values containing newlines use the binary length-prefixed format.
+func writeJournaldField(buf *bytes.Buffer, key, value string) {
+	if value == "" {
+		return
+	}
+
+	buf.WriteString(key)
+
+	if !strings.Contains(value, "\n") {
+		buf.WriteByte('=')
+		buf.WriteString(value)
+		buf.WriteByte('\n')
+
+		return
+	}
+
+	buf.WriteByte('\n')
+	_ = binary.Write(buf, binary.LittleEndian, uint64(len(value)))
+	buf.WriteString(value)
+	buf.WriteByte('\n')
+}
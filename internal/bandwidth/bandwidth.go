@@ -0,0 +1,111 @@
+// Package bandwidth parses the pod bandwidth annotations and shapes the pod
+// traffic accordingly, independent of the CNI bandwidth plugin.
+package bandwidth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// IngressAnnotation limits the bandwidth of the traffic sent to the pod.
+	IngressAnnotation = "kubernetes.io/ingress-bandwidth"
+
+	// EgressAnnotation limits the bandwidth of the traffic sent by the pod.
+	EgressAnnotation = "kubernetes.io/egress-bandwidth"
+
+	// DefaultBurst is the burst in bits used for every configured rate.
+	DefaultBurst = math.MaxUint32*8 - 1 // 4GB burst limit
+)
+
+const (
+	// ShapingCNI passes the bandwidth limits to the CNI plugins, which requires
+	// the bandwidth plugin to be chained.
+	ShapingCNI = "cni"
+
+	// ShapingNative applies the bandwidth limits by CRI-O itself.
+	ShapingNative = "native"
+)
+
+// Limits are the bandwidth limits of a pod. The rates are in bits per second
+// and the bursts in bits, a zero rate means unlimited.
+type Limits struct {
+	IngressRate  uint64
+	IngressBurst uint64
+	EgressRate   uint64
+	EgressBurst  uint64
+}
+
+// FromAnnotations returns the bandwidth limits configured by the provided pod
+// annotations, or nil if none are configured.
+func FromAnnotations(annotations map[string]string) (*Limits, error) {
+	ingress, err := parseRate(annotations, IngressAnnotation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ingress bandwidth: %w", err)
+	}
+
+	egress, err := parseRate(annotations, EgressAnnotation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse egress bandwidth: %w", err)
+	}
+
+	if ingress == 0 && egress == 0 {
+		return nil, nil
+	}
+
+	limits := &Limits{}
+	if ingress > 0 {
+		limits.IngressRate = ingress
+		limits.IngressBurst = DefaultBurst
+	}
+
+	if egress > 0 {
+		limits.EgressRate = egress
+		limits.EgressBurst = DefaultBurst
+	}
+
+	return limits, nil
+}
+
+func parseRate(annotations map[string]string, annotation string) (uint64, error) {
+	val, ok := annotations[annotation]
+	if !ok {
+		return 0, nil
+	}
+
+	quantity, err := resource.ParseQuantity(val)
+	if err != nil {
+		return 0, err
+	}
+
+	if rate, isok := quantity.AsInt64(); isok && rate > 0 {
+		return uint64(rate), nil
+	}
+
+	return 0, nil
+}
+
+// ValidateShaping returns an error if the provided shaping mode is unknown.
+func ValidateShaping(shaping string) error {
+	switch shaping {
+	case ShapingCNI, ShapingNative:
+		return nil
+	default:
+		return fmt.Errorf("unsupported bandwidth shaping %q, must be %q or %q", shaping, ShapingCNI, ShapingNative)
+	}
+}
+
+// ifbName returns the name of the intermediate functional block device used
+// to shape the egress traffic of the provided pod, which must not exceed 15
+// characters.
+func ifbName(podID string) string {
+	const prefix = "bw-"
+
+	hash := sha256.Sum256([]byte(podID))
+
+	return prefix + hex.EncodeToString(hash[:])[:12]
+}
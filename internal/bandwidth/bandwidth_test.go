@@ -0,0 +1,106 @@
+package bandwidth_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cri-o/cri-o/internal/bandwidth"
+)
+
+var _ = t.Describe("Bandwidth", func() {
+	t.Describe("FromAnnotations", func() {
+		It("should return nil without annotations", func() {
+			// When
+			limits, err := bandwidth.FromAnnotations(map[string]string{"foo": "bar"})
+
+			// Then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(limits).To(BeNil())
+		})
+
+		It("should parse the ingress and egress rates", func() {
+			// When
+			limits, err := bandwidth.FromAnnotations(map[string]string{
+				bandwidth.IngressAnnotation: "10M",
+				bandwidth.EgressAnnotation:  "1Gi",
+			})
+
+			// Then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(limits).To(Equal(&bandwidth.Limits{
+				IngressRate:  10_000_000,
+				IngressBurst: bandwidth.DefaultBurst,
+				EgressRate:   1 << 30,
+				EgressBurst:  bandwidth.DefaultBurst,
+			}))
+		})
+
+		It("should only limit the configured direction", func() {
+			// When
+			limits, err := bandwidth.FromAnnotations(map[string]string{
+				bandwidth.EgressAnnotation: "100k",
+			})
+
+			// Then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(limits.IngressRate).To(BeZero())
+			Expect(limits.IngressBurst).To(BeZero())
+			Expect(limits.EgressRate).To(BeEquivalentTo(100_000))
+		})
+
+		It("should ignore a zero rate", func() {
+			// When
+			limits, err := bandwidth.FromAnnotations(map[string]string{
+				bandwidth.IngressAnnotation: "0",
+			})
+
+			// Then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(limits).To(BeNil())
+		})
+
+		It("should fail on an invalid rate", func() {
+			// When
+			_, err := bandwidth.FromAnnotations(map[string]string{
+				bandwidth.IngressAnnotation: "fast",
+			})
+
+			// Then
+			Expect(err).To(MatchError(ContainSubstring("ingress bandwidth")))
+		})
+	})
+
+	t.Describe("ValidateShaping", func() {
+		DescribeTable("should validate the shaping mode",
+			func(shaping string, valid bool) {
+				err := bandwidth.ValidateShaping(shaping)
+				if valid {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(HaveOccurred())
+				}
+			},
+			Entry("cni", bandwidth.ShapingCNI, true),
+			Entry("native", bandwidth.ShapingNative, true),
+			Entry("empty", "", false),
+			Entry("unknown", "tc", false),
+		)
+	})
+
+	t.Describe("IFBName", func() {
+		It("should distinguish pods with a common ID prefix", func() {
+			// Given
+			const prefix = "0123456789ab"
+
+			// When
+			first := bandwidth.IFBName(prefix + "cdef")
+			second := bandwidth.IFBName(prefix + "fedc")
+
+			// Then
+			Expect(first).NotTo(Equal(second))
+			Expect(first).To(HavePrefix("bw-"))
+			Expect(first).To(HaveLen(15))
+			Expect(second).To(HaveLen(15))
+		})
+	})
+})
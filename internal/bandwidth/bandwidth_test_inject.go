@@ -0,0 +1,12 @@
+//go:build test
+
+// All *_inject.go files are meant to be used by tests only. Purpose of this
+// files is to provide a way to inject mocked data into the current setup.
+
+package bandwidth
+
+// IFBName returns the name of the intermediate functional block device of the
+// provided pod.
+func IFBName(podID string) string {
+	return ifbName(podID)
+}
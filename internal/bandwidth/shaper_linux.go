@@ -0,0 +1,180 @@
+//go:build linux
+
+package bandwidth
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// latency is the maximum time a packet may sit in the token bucket, used to
+// calculate the queue limit like the CNI bandwidth plugin does.
+const latency = 0.025 // 25ms
+
+// Apply shapes the traffic of the pod by using tc on the host side of the veth
+// pair whose pod side is podInterface in the provided network namespace. The
+// ingress traffic of the pod is limited by a token bucket filter on the veth,
+// the egress traffic is redirected to an intermediate functional block device
+// and limited there.
+func Apply(podID, netnsPath, podInterface string, limits *Limits) error {
+	hostVeth, err := hostVeth(netnsPath, podInterface)
+	if err != nil {
+		return err
+	}
+
+	if limits.IngressRate > 0 {
+		if err := replaceTBF(hostVeth, limits.IngressRate, limits.IngressBurst); err != nil {
+			return fmt.Errorf("limit ingress bandwidth: %w", err)
+		}
+	}
+
+	if limits.EgressRate > 0 {
+		if err := shapeEgress(podID, hostVeth, limits.EgressRate, limits.EgressBurst); err != nil {
+			return fmt.Errorf("limit egress bandwidth: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Remove removes the egress shaping device of the provided pod. The shaping
+// of the veth gets removed together with the veth itself.
+func Remove(podID string) error {
+	link, err := netlink.LinkByName(ifbName(podID))
+	if err != nil {
+		if errors.As(err, &netlink.LinkNotFoundError{}) {
+			return nil
+		}
+
+		return fmt.Errorf("get ifb device: %w", err)
+	}
+
+	if err := netlink.LinkDel(link); err != nil {
+		return fmt.Errorf("delete ifb device %s: %w", link.Attrs().Name, err)
+	}
+
+	return nil
+}
+
+// hostVeth returns the host side of the veth pair whose pod side is
+// podInterface in the provided network namespace.
+func hostVeth(netnsPath, podInterface string) (netlink.Link, error) {
+	netns, err := ns.GetNS(netnsPath)
+	if err != nil {
+		return nil, fmt.Errorf("open network namespace: %w", err)
+	}
+	defer netns.Close()
+
+	var peerIndex int
+
+	if err := netns.Do(func(ns.NetNS) error {
+		link, err := netlink.LinkByName(podInterface)
+		if err != nil {
+			return fmt.Errorf("get pod interface %s: %w", podInterface, err)
+		}
+
+		if link.Type() != "veth" {
+			return fmt.Errorf("pod interface %s is of type %s, not veth", podInterface, link.Type())
+		}
+
+		peerIndex = link.Attrs().ParentIndex
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	link, err := netlink.LinkByIndex(peerIndex)
+	if err != nil {
+		return nil, fmt.Errorf("get host side of pod interface %s: %w", podInterface, err)
+	}
+
+	return link, nil
+}
+
+// shapeEgress redirects the ingress traffic of the host veth, which is the
+// egress traffic of the pod, to an intermediate functional block device and
+// limits it there.
+func shapeEgress(podID string, hostVeth netlink.Link, rate, burst uint64) error {
+	// Remove the leftovers of a previous attempt.
+	if err := Remove(podID); err != nil {
+		return err
+	}
+
+	ifb := &netlink.Ifb{
+		LinkAttrs: netlink.LinkAttrs{
+			Name: ifbName(podID),
+			MTU:  hostVeth.Attrs().MTU,
+		},
+	}
+	if err := netlink.LinkAdd(ifb); err != nil {
+		return fmt.Errorf("add ifb device %s: %w", ifb.Name, err)
+	}
+
+	if err := netlink.LinkSetUp(ifb); err != nil {
+		return fmt.Errorf("set ifb device %s up: %w", ifb.Name, err)
+	}
+
+	ingress := &netlink.Ingress{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: hostVeth.Attrs().Index,
+			Handle:    netlink.MakeHandle(0xffff, 0),
+			Parent:    netlink.HANDLE_INGRESS,
+		},
+	}
+	if err := netlink.QdiscReplace(ingress); err != nil {
+		return fmt.Errorf("add ingress qdisc: %w", err)
+	}
+
+	filter := &netlink.U32{
+		FilterAttrs: netlink.FilterAttrs{
+			LinkIndex: hostVeth.Attrs().Index,
+			Parent:    ingress.Handle,
+			Priority:  1,
+			Protocol:  unix.ETH_P_ALL,
+		},
+		ClassId: netlink.MakeHandle(1, 1),
+		Actions: []netlink.Action{netlink.NewMirredAction(ifb.Index)},
+	}
+	if err := netlink.FilterReplace(filter); err != nil {
+		return fmt.Errorf("add redirect filter: %w", err)
+	}
+
+	return replaceTBF(ifb, rate, burst)
+}
+
+// replaceTBF adds or replaces the root token bucket filter of the provided
+// link.
+func replaceTBF(link netlink.Link, rateInBits, burstInBits uint64) error {
+	rate := rateInBits / 8
+	burst := float64(burstInBits / 8)
+
+	qdisc := &netlink.Tbf{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: link.Attrs().Index,
+			Handle:    netlink.MakeHandle(1, 0),
+			Parent:    netlink.HANDLE_ROOT,
+		},
+		Rate:   rate,
+		Buffer: clampUint32(burst * netlink.TIME_UNITS_PER_SEC / float64(rate) * netlink.TickInUsec()),
+		Limit:  clampUint32(float64(rate)*latency + burst),
+	}
+	if err := netlink.QdiscReplace(qdisc); err != nil {
+		return fmt.Errorf("add tbf qdisc to %s: %w", link.Attrs().Name, err)
+	}
+
+	return nil
+}
+
+func clampUint32(val float64) uint32 {
+	if val > math.MaxUint32 {
+		return math.MaxUint32
+	}
+
+	return uint32(val)
+}
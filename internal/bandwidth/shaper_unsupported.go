@@ -0,0 +1,19 @@
+//go:build !linux
+
+package bandwidth
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// Apply shapes the traffic of the pod, which is unsupported on this platform.
+func Apply(podID, netnsPath, podInterface string, limits *Limits) error {
+	return fmt.Errorf("native bandwidth shaping unsupported on %s", runtime.GOOS)
+}
+
+// Remove removes the shaping of the pod traffic, which is a no-op on this
+// platform.
+func Remove(podID string) error {
+	return nil
+}
@@ -3,10 +3,12 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
+	"strings"
 	"syscall"
 	"time"
 
@@ -25,6 +27,11 @@ type CrioClient interface {
 	ConfigInfo(context.Context) (string, error)
 	GoRoutinesInfo(context.Context) (string, error)
 	HeapInfo(context.Context) ([]byte, error)
+	SessionsInfo(context.Context) ([]types.StreamSessionInfo, error)
+	TerminateSession(context.Context, string) error
+	PressureEvents(context.Context, func(*types.PressureEvent)) error
+	HostPortReservations(context.Context) ([]types.HostPortReservation, error)
+	NetworkStatus(context.Context) (*types.NetworkStatus, error)
 }
 
 type crioClientImpl struct {
@@ -63,6 +70,28 @@ func New(crioSocketPath string) (CrioClient, error) {
 }
 
 func (c *crioClientImpl) doGetRequest(ctx context.Context, path string) ([]byte, error) {
+	body, _, err := c.doGetRequestWithStatus(ctx, path)
+
+	return body, err
+}
+
+func (c *crioClientImpl) doGetRequestWithStatus(ctx context.Context, path string) ([]byte, int, error) {
+	resp, err := c.doGet(ctx, path)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp.StatusCode, fmt.Errorf("read body: %w", err)
+	}
+
+	return body, resp.StatusCode, nil
+}
+
+func (c *crioClientImpl) doGet(ctx context.Context, path string) (*http.Response, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, path, http.NoBody)
 	if err != nil {
 		return nil, err
@@ -78,14 +107,7 @@ func (c *crioClientImpl) doGetRequest(ctx context.Context, path string) ([]byte,
 		return nil, fmt.Errorf("do get request: %w", err)
 	}
 
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("read body: %w", err)
-	}
-
-	return body, nil
+	return resp, nil
 }
 
 // DaemonInfo return cri-o daemon info from the cri-o
@@ -148,3 +170,96 @@ func (c *crioClientImpl) HeapInfo(ctx context.Context) ([]byte, error) {
 
 	return body, nil
 }
+
+// SessionsInfo returns the active exec and attach sessions.
+func (c *crioClientImpl) SessionsInfo(ctx context.Context) ([]types.StreamSessionInfo, error) {
+	body, err := c.doGetRequest(ctx, server.InspectSessionsEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := []types.StreamSessionInfo{}
+	if err := json.Unmarshal(body, &sessions); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// TerminateSession forcefully terminates the exec or attach session with the
+// provided ID.
+func (c *crioClientImpl) TerminateSession(ctx context.Context, id string) error {
+	body, status, err := c.doGetRequestWithStatus(ctx, server.InspectTerminateEndpoint+"/"+id)
+	if err != nil {
+		return err
+	}
+
+	if status != http.StatusOK {
+		return fmt.Errorf("terminate session %s: %s", id, strings.TrimSpace(string(body)))
+	}
+
+	return nil
+}
+
+// PressureEvents calls the provided function for every container pressure
+// event until the context gets canceled or the server closes the stream.
+func (c *crioClientImpl) PressureEvents(ctx context.Context, fn func(*types.PressureEvent)) error {
+	resp, err := c.doGet(ctx, server.InspectPressureEventsEndpoint)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+
+		return fmt.Errorf("follow pressure events: %s", strings.TrimSpace(string(body)))
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+
+	for {
+		event := &types.PressureEvent{}
+		if err := decoder.Decode(event); err != nil {
+			if errors.Is(err, io.EOF) || ctx.Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("decode pressure event: %w", err)
+		}
+
+		fn(event)
+	}
+}
+
+// HostPortReservations returns the host ports currently reserved by pod
+// sandboxes.
+func (c *crioClientImpl) HostPortReservations(ctx context.Context) ([]types.HostPortReservation, error) {
+	body, err := c.doGetRequest(ctx, server.InspectHostPortsEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	reservations := []types.HostPortReservation{}
+	if err := json.Unmarshal(body, &reservations); err != nil {
+		return nil, err
+	}
+
+	return reservations, nil
+}
+
+// NetworkStatus returns diagnostic information about the pod network backend.
+func (c *crioClientImpl) NetworkStatus(ctx context.Context) (*types.NetworkStatus, error) {
+	body, err := c.doGetRequest(ctx, server.InspectNetworkEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &types.NetworkStatus{}
+	if err := json.Unmarshal(body, status); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
 	"sync"
 	"time"
 
+	"github.com/containernetworking/cni/libcni"
 	"github.com/cri-o/ocicni/pkg/ocicni"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -23,6 +25,28 @@ type CNIManager struct {
 	mutex     sync.RWMutex
 
 	validPodList PodNetworkLister
+
+	// networkDir and pluginDirs are the directories the plugin got
+	// initialized with, used for diagnostics only.
+	networkDir string
+	pluginDirs []string
+
+	lastPoll    time.Time
+	lastGC      time.Time
+	lastGCPods  int
+	lastGCError error
+
+	// defaultPlugins caches the plugin types of the default network.
+	defaultPlugins      defaultNetworkPlugins
+	defaultPluginsMutex sync.Mutex
+}
+
+// defaultNetworkPlugins are the plugin types of a network, loaded from the
+// network directory at the provided modification time.
+type defaultNetworkPlugins struct {
+	network string
+	modTime time.Time
+	plugins []string
 }
 
 func New(defaultNetwork, networkDir string, pluginDirs ...string) (*CNIManager, error) {
@@ -35,8 +59,10 @@ func New(defaultNetwork, networkDir string, pluginDirs ...string) (*CNIManager,
 	}
 
 	mgr := &CNIManager{
-		plugin:    plugin,
-		lastError: errors.New("plugin status uninitialized"),
+		plugin:     plugin,
+		lastError:  errors.New("plugin status uninitialized"),
+		networkDir: networkDir,
+		pluginDirs: pluginDirs,
 	}
 	go mgr.pollUntilReady()
 
@@ -56,6 +82,8 @@ func (c *CNIManager) pollFunc() (bool, error) {
 		return true, nil
 	}
 
+	c.lastPoll = time.Now()
+
 	if err := c.plugin.Status(); err != nil {
 		c.lastError = err
 
@@ -92,6 +120,42 @@ func (c *CNIManager) Plugin() ocicni.CNIPlugin {
 	return c.plugin
 }
 
+// DefaultNetworkPlugins returns the plugin types of the default network, or
+// nil if they cannot be determined. The plugin types are cached, and only
+// reloaded if the default network or the network directory changed.
+func (c *CNIManager) DefaultNetworkPlugins() []string {
+	network := c.plugin.GetDefaultNetworkName()
+	if network == "" || c.networkDir == "" {
+		return nil
+	}
+
+	info, err := os.Stat(c.networkDir)
+	if err != nil {
+		return nil
+	}
+
+	c.defaultPluginsMutex.Lock()
+	defer c.defaultPluginsMutex.Unlock()
+
+	if c.defaultPlugins.network == network && c.defaultPlugins.modTime.Equal(info.ModTime()) {
+		return c.defaultPlugins.plugins
+	}
+
+	confList, err := libcni.LoadConfList(c.networkDir, network)
+	if err != nil {
+		return nil
+	}
+
+	plugins := make([]string, 0, len(confList.Plugins))
+	for _, plugin := range confList.Plugins {
+		plugins = append(plugins, plugin.Network.Type)
+	}
+
+	c.defaultPlugins = defaultNetworkPlugins{network: network, modTime: info.ModTime(), plugins: plugins}
+
+	return plugins
+}
+
 // Add watcher creates a new watcher for the CNI manager
 // said watcher will send a `true` value if the CNI plugin was successfully ready
 // or `false` if the server shutdown first.
@@ -140,13 +204,20 @@ func (c *CNIManager) doGC(ctx context.Context) error {
 		return nil
 	}
 
+	c.lastGC = time.Now()
+
 	validPods, err := c.validPodList()
 	if err != nil {
+		c.lastGCError = err
+
 		return err
 	}
 	// give a GC call 30s
 	stopCtx, stopCancel := context.WithTimeout(ctx, 30*time.Second)
 	defer stopCancel()
 
-	return c.plugin.GC(stopCtx, validPods)
+	c.lastGCPods = len(validPods)
+	c.lastGCError = c.plugin.GC(stopCtx, validPods)
+
+	return c.lastGCError
 }
@@ -0,0 +1,157 @@
+package cnimgr
+
+import (
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/containernetworking/cni/libcni"
+)
+
+// Diagnostics is a snapshot of the CNI manager state, used to find out why the
+// network is not ready.
+type Diagnostics struct {
+	// ReadyError is nil if the plugin is ready, otherwise the last error
+	// received while polling its status.
+	ReadyError error
+	// LastPoll is the last time the plugin status got polled.
+	LastPoll time.Time
+	// DefaultNetwork is the network currently chosen as default.
+	DefaultNetwork string
+	// NetworkDir is the directory scanned for CNI configuration files.
+	NetworkDir string
+	// ConfigFiles are the CNI configuration files found in NetworkDir.
+	ConfigFiles []ConfigFile
+	// PluginDirs are the directories scanned for CNI plugin binaries.
+	PluginDirs []PluginDir
+	// MissingPlugins are the plugin types referenced by the default network
+	// which could not be found in any of the PluginDirs.
+	MissingPlugins []string
+	// LastGC is the last time stale network resources got garbage collected.
+	LastGC time.Time
+	// LastGCPods is the amount of valid pods passed to the last GC.
+	LastGCPods int
+	// LastGCError is the error returned by the last GC, if any.
+	LastGCError error
+}
+
+// ConfigFile is a CNI configuration file found in the network directory.
+type ConfigFile struct {
+	Path    string
+	Network string
+	Plugins []string
+	Error   error
+}
+
+// PluginDir is a directory containing CNI plugin binaries.
+type PluginDir struct {
+	Path     string
+	Binaries []string
+	Error    error
+}
+
+// Diagnostics returns a snapshot of the current CNI manager state. The network
+// and plugin directories are read on every call.
+func (c *CNIManager) Diagnostics() *Diagnostics {
+	c.mutex.RLock()
+	diag := &Diagnostics{
+		ReadyError:  c.lastError,
+		LastPoll:    c.lastPoll,
+		NetworkDir:  c.networkDir,
+		LastGC:      c.lastGC,
+		LastGCPods:  c.lastGCPods,
+		LastGCError: c.lastGCError,
+	}
+	pluginDirs := slices.Clone(c.pluginDirs)
+	c.mutex.RUnlock()
+
+	if c.plugin != nil {
+		diag.DefaultNetwork = c.plugin.GetDefaultNetworkName()
+	}
+
+	var defaultPlugins []string
+
+	if diag.NetworkDir != "" {
+		diag.ConfigFiles = configFiles(diag.NetworkDir)
+		for i := range diag.ConfigFiles {
+			if diag.ConfigFiles[i].Network == diag.DefaultNetwork {
+				defaultPlugins = diag.ConfigFiles[i].Plugins
+
+				break
+			}
+		}
+	}
+
+	found := map[string]bool{}
+
+	for _, dir := range pluginDirs {
+		pluginDir := PluginDir{Path: dir}
+
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			pluginDir.Error = err
+		}
+
+		for _, entry := range entries {
+			info, err := entry.Info()
+			if err != nil || !info.Mode().IsRegular() || info.Mode().Perm()&0o111 == 0 {
+				continue
+			}
+
+			pluginDir.Binaries = append(pluginDir.Binaries, entry.Name())
+			found[entry.Name()] = true
+		}
+
+		diag.PluginDirs = append(diag.PluginDirs, pluginDir)
+	}
+
+	for _, plugin := range defaultPlugins {
+		if !found[plugin] && !slices.Contains(diag.MissingPlugins, plugin) {
+			diag.MissingPlugins = append(diag.MissingPlugins, plugin)
+		}
+	}
+
+	return diag
+}
+
+// configFiles returns the CNI configuration files of the provided directory,
+// in the order ocicni considers them when choosing the default network.
+func configFiles(dir string) []ConfigFile {
+	files, err := libcni.ConfFiles(dir, []string{".conf", ".conflist", ".json"})
+	if err != nil {
+		return []ConfigFile{{Path: dir, Error: err}}
+	}
+
+	slices.Sort(files)
+
+	res := make([]ConfigFile, 0, len(files))
+
+	for _, file := range files {
+		configFile := ConfigFile{Path: file}
+
+		if strings.HasSuffix(file, ".conflist") {
+			confList, err := libcni.ConfListFromFile(file)
+			if err != nil {
+				configFile.Error = err
+			} else {
+				configFile.Network = confList.Name
+				for _, plugin := range confList.Plugins {
+					configFile.Plugins = append(configFile.Plugins, plugin.Network.Type)
+				}
+			}
+		} else {
+			conf, err := libcni.ConfFromFile(file)
+			if err != nil {
+				configFile.Error = err
+			} else {
+				configFile.Network = conf.Network.Name
+				configFile.Plugins = []string{conf.Network.Type}
+			}
+		}
+
+		res = append(res, configFile)
+	}
+
+	return res
+}
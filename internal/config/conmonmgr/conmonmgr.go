@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"path"
 	"strings"
+	"sync"
 
 	"github.com/blang/semver/v4"
 	"github.com/sirupsen/logrus"
@@ -18,9 +19,12 @@ var (
 )
 
 type ConmonManager struct {
+	conmonPath               string
 	conmonVersion            *semver.Version
 	supportsSync             bool
 	supportsLogGlobalSizeMax bool
+	supportsLogLabel         bool
+	supportsLogLabelOnce     sync.Once
 }
 
 // this function is heavily based on go.podman.io/common#probeConmon.
@@ -39,7 +43,7 @@ func New(conmonPath string) (*ConmonManager, error) {
 		return nil, fmt.Errorf("conmon version output too short: expected three fields, got %d in %s", len(fields), out)
 	}
 
-	c := new(ConmonManager)
+	c := &ConmonManager{conmonPath: conmonPath}
 	if err := c.parseConmonVersion(fields[2]); err != nil {
 		return nil, fmt.Errorf("parse conmon version: %w", err)
 	}
@@ -96,3 +100,24 @@ func (c *ConmonManager) initializeSupportsSync() {
 func (c *ConmonManager) SupportsSync() bool {
 	return c.supportsSync
 }
+
+// SupportsLogLabel returns true if conmon supports the --log-label option.
+// The option is detected from the help output on first use, because it is
+// only required by the journald log driver.
+func (c *ConmonManager) SupportsLogLabel() bool {
+	c.supportsLogLabelOnce.Do(c.initializeSupportsLogLabel)
+
+	return c.supportsLogLabel
+}
+
+func (c *ConmonManager) initializeSupportsLogLabel() {
+	helpOutput, err := cmdrunner.CombinedOutput(c.conmonPath, "--help")
+	c.supportsLogLabel = err == nil && bytes.Contains(helpOutput, []byte("--log-label"))
+
+	verb := "does not"
+	if c.supportsLogLabel {
+		verb = "does"
+	}
+
+	logrus.Infof("Conmon %s support the --log-label option", verb)
+}
@@ -276,4 +276,62 @@ var _ = t.Describe("ConmonManager", func() {
 			Expect(mgr.SupportsLogGlobalSizeMax()).To(BeTrue())
 		})
 	})
+	t.Describe("SupportsLogLabel", func() {
+		var mgr *ConmonManager
+		BeforeEach(func() {
+			runner = runnerMock.NewMockCommandRunner(mockCtrl)
+			cmdrunner.SetMocked(runner)
+			mgr = &ConmonManager{conmonPath: validPath}
+		})
+		It("should be true if the help output contains the option", func() {
+			// Given
+			gomock.InOrder(
+				runner.EXPECT().CombinedOutput(validPath, "--help").Return([]byte("--log-label"), nil),
+			)
+
+			// When
+			supported := mgr.SupportsLogLabel()
+
+			// Then
+			Expect(supported).To(BeTrue())
+		})
+		It("should be false if the help output misses the option", func() {
+			// Given
+			gomock.InOrder(
+				runner.EXPECT().CombinedOutput(validPath, "--help").Return([]byte("--log-tag"), nil),
+			)
+
+			// When
+			supported := mgr.SupportsLogLabel()
+
+			// Then
+			Expect(supported).To(BeFalse())
+		})
+		It("should be false if the help command fails", func() {
+			// Given
+			gomock.InOrder(
+				runner.EXPECT().CombinedOutput(validPath, "--help").Return([]byte{}, errors.New("cmd failed")),
+			)
+
+			// When
+			supported := mgr.SupportsLogLabel()
+
+			// Then
+			Expect(supported).To(BeFalse())
+		})
+		It("should detect the option only once", func() {
+			// Given
+			gomock.InOrder(
+				runner.EXPECT().CombinedOutput(validPath, "--help").Return([]byte("--log-label"), nil).Times(1),
+			)
+
+			// When
+			first := mgr.SupportsLogLabel()
+			second := mgr.SupportsLogLabel()
+
+			// Then
+			Expect(first).To(BeTrue())
+			Expect(second).To(BeTrue())
+		})
+	})
 })
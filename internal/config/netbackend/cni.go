@@ -0,0 +1,59 @@
+package netbackend
+
+import (
+	"context"
+	"errors"
+
+	cnitypes "github.com/containernetworking/cni/pkg/types"
+	"github.com/cri-o/ocicni/pkg/ocicni"
+
+	"github.com/cri-o/cri-o/internal/config/cnimgr"
+)
+
+// cniBackend is the network backend using the CNI plugins managed by a CNI
+// manager.
+type cniBackend struct {
+	manager *cnimgr.CNIManager
+}
+
+// NewCNI creates a new network backend using the plugin of the provided CNI
+// manager.
+func NewCNI(manager *cnimgr.CNIManager) Backend {
+	return &cniBackend{manager: manager}
+}
+
+func (b *cniBackend) Name() string {
+	return CNI
+}
+
+func (b *cniBackend) DefaultNetworkName() string {
+	return b.manager.Plugin().GetDefaultNetworkName()
+}
+
+func (b *cniBackend) SetUpPod(ctx context.Context, network ocicni.PodNetwork) error {
+	_, err := b.manager.Plugin().SetUpPodWithContext(ctx, network)
+
+	return err
+}
+
+func (b *cniBackend) TearDownPod(ctx context.Context, network ocicni.PodNetwork) error {
+	return b.manager.Plugin().TearDownPodWithContext(ctx, network)
+}
+
+func (b *cniBackend) Status(ctx context.Context, network ocicni.PodNetwork) (cnitypes.Result, error) {
+	results, err := b.manager.Plugin().GetPodNetworkStatusWithContext(ctx, network)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only one result is returned since no additional networks are requested.
+	if len(results) == 0 {
+		return nil, errors.New("no network status returned by CNI plugin")
+	}
+
+	return results[0].Result, nil
+}
+
+func (b *cniBackend) GC(ctx context.Context, validPodList PodNetworkLister) error {
+	return b.manager.GC(ctx, cnimgr.PodNetworkLister(validPodList))
+}
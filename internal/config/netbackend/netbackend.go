@@ -0,0 +1,49 @@
+package netbackend
+
+import (
+	"context"
+
+	cnitypes "github.com/containernetworking/cni/pkg/types"
+	"github.com/cri-o/ocicni/pkg/ocicni"
+)
+
+const (
+	// CNI sets up the pod network by using the configured CNI plugins.
+	CNI = "cni"
+
+	// Static assigns the pod addresses from a configured pool and attaches the
+	// pods to a bridge by using veth pairs.
+	Static = "static"
+
+	// DefaultBackend is the network backend used if none is configured.
+	DefaultBackend = CNI
+)
+
+// PodNetworkLister returns the networks of all valid pods.
+type PodNetworkLister func() ([]*ocicni.PodNetwork, error)
+
+// Backend is the interface to set up and tear down the network of pod
+// sandboxes. The CRI-O server uses it regardless of which backend has been
+// configured.
+type Backend interface {
+	// Name returns the name of the backend, for example "cni".
+	Name() string
+
+	// DefaultNetworkName returns the name of the network pods get attached to.
+	DefaultNetworkName() string
+
+	// SetUpPod attaches the pod to the network.
+	SetUpPod(ctx context.Context, network ocicni.PodNetwork) error
+
+	// TearDownPod detaches the pod from the network and releases its
+	// addresses. It must succeed even if the network namespace is already
+	// gone.
+	TearDownPod(ctx context.Context, network ocicni.PodNetwork) error
+
+	// Status returns the network result of a pod which has been set up.
+	Status(ctx context.Context, network ocicni.PodNetwork) (cnitypes.Result, error)
+
+	// GC releases the network resources of all pods not returned by
+	// validPodList.
+	GC(ctx context.Context, validPodList PodNetworkLister) error
+}
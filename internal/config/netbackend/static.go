@@ -0,0 +1,377 @@
+package netbackend
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	cnitypes "github.com/containernetworking/cni/pkg/types"
+	cnicurrent "github.com/containernetworking/cni/pkg/types/100"
+	"github.com/cri-o/ocicni/pkg/ocicni"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultStaticBridge is the default bridge of the static network backend.
+	DefaultStaticBridge = "crio0"
+
+	// podInterfaceName is the name of the interface inside the pod.
+	podInterfaceName = "eth0"
+
+	// hostInterfacePrefix is the prefix of the host side veth interfaces.
+	hostInterfacePrefix = "veth"
+)
+
+// StaticConfig is the configuration of the static network backend.
+type StaticConfig struct {
+	// Subnets are the address pools of the pods, at most one per IP family.
+	// The first address of each subnet is assigned to the bridge and used
+	// as gateway.
+	Subnets []string
+
+	// Bridge is the name of the host bridge the pods get attached to.
+	Bridge string
+
+	// StateDir is the directory where the address allocations are
+	// persisted.
+	StateDir string
+}
+
+// Validate checks the configuration and returns the parsed subnets.
+func (c *StaticConfig) Validate() ([]netip.Prefix, error) {
+	if len(c.Subnets) == 0 {
+		return nil, errors.New("no subnets configured")
+	}
+
+	subnets := make([]netip.Prefix, 0, len(c.Subnets))
+	families := map[bool]bool{}
+
+	for _, subnet := range c.Subnets {
+		prefix, err := netip.ParsePrefix(subnet)
+		if err != nil {
+			return nil, fmt.Errorf("invalid subnet: %w", err)
+		}
+
+		prefix = prefix.Masked()
+		if prefix.Addr().Is4() && prefix.Bits() > 30 || prefix.Addr().Is6() && prefix.Bits() > 126 {
+			return nil, fmt.Errorf("subnet %s is too small", subnet)
+		}
+
+		if families[prefix.Addr().Is4()] {
+			return nil, fmt.Errorf("more than one subnet configured for the IP family of %s", subnet)
+		}
+
+		families[prefix.Addr().Is4()] = true
+
+		subnets = append(subnets, prefix)
+	}
+
+	if c.Bridge == "" {
+		return nil, errors.New("no bridge configured")
+	}
+
+	return subnets, nil
+}
+
+// staticLinks sets up and removes the interfaces of pods for the static
+// network backend.
+type staticLinks interface {
+	// setUp ensures that the bridge exists, connects it to the network
+	// namespace by using a veth pair and configures the addresses and routes
+	// of the result.
+	setUp(netnsPath, hostInterface string, result *cnicurrent.Result) error
+
+	// tearDown removes the veth pair of a pod. It does not fail if the pair
+	// does not exist.
+	tearDown(hostInterface string) error
+}
+
+// staticBackend is the network backend assigning the pod addresses from a
+// configured pool.
+type staticBackend struct {
+	config  *StaticConfig
+	subnets []netip.Prefix
+	links   staticLinks
+	mutex   sync.Mutex
+}
+
+// NewStatic creates a new static network backend.
+func NewStatic(config *StaticConfig) (Backend, error) {
+	subnets, err := config.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid static network configuration: %w", err)
+	}
+
+	if err := os.MkdirAll(config.StateDir, 0o700); err != nil {
+		return nil, fmt.Errorf("create static network state directory: %w", err)
+	}
+
+	return &staticBackend{
+		config:  config,
+		subnets: subnets,
+		links:   newStaticLinks(config.Bridge),
+	}, nil
+}
+
+func (b *staticBackend) Name() string {
+	return Static
+}
+
+func (b *staticBackend) DefaultNetworkName() string {
+	return Static
+}
+
+func (b *staticBackend) SetUpPod(ctx context.Context, network ocicni.PodNetwork) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	addrs, err := b.allocate(network.ID)
+	if err != nil {
+		return fmt.Errorf("allocate addresses: %w", err)
+	}
+
+	if err := b.links.setUp(network.NetNS, hostInterfaceName(network.ID), b.result(network, addrs)); err != nil {
+		b.release(network.ID)
+
+		return fmt.Errorf("set up pod interfaces: %w", err)
+	}
+
+	return nil
+}
+
+func (b *staticBackend) TearDownPod(ctx context.Context, network ocicni.PodNetwork) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if err := b.links.tearDown(hostInterfaceName(network.ID)); err != nil {
+		return fmt.Errorf("tear down pod interfaces: %w", err)
+	}
+
+	b.release(network.ID)
+
+	return nil
+}
+
+func (b *staticBackend) Status(ctx context.Context, network ocicni.PodNetwork) (cnitypes.Result, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	allocations, err := b.allocations()
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := allocations[network.ID]
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("no addresses allocated for pod %s", network.ID)
+	}
+
+	return b.result(network, addrs), nil
+}
+
+func (b *staticBackend) GC(ctx context.Context, validPodList PodNetworkLister) error {
+	validPods, err := validPodList()
+	if err != nil {
+		return err
+	}
+
+	valid := make(map[string]bool, len(validPods))
+	for _, pod := range validPods {
+		valid[pod.ID] = true
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	allocations, err := b.allocations()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for id := range allocations {
+		if valid[id] {
+			continue
+		}
+
+		logrus.Infof("Releasing static network addresses of stale pod %s", id)
+
+		if err := b.links.tearDown(hostInterfaceName(id)); err != nil {
+			errs = append(errs, fmt.Errorf("tear down interfaces of pod %s: %w", id, err))
+
+			continue
+		}
+
+		b.release(id)
+	}
+
+	return errors.Join(errs...)
+}
+
+// allocate returns the addresses of the pod, allocating one from each subnet
+// if not done yet.
+func (b *staticBackend) allocate(id string) ([]netip.Addr, error) {
+	allocations, err := b.allocations()
+	if err != nil {
+		return nil, err
+	}
+
+	res := []netip.Addr{}
+
+	for _, subnet := range b.subnets {
+		if i := slices.IndexFunc(allocations[id], subnet.Contains); i >= 0 {
+			res = append(res, allocations[id][i])
+
+			continue
+		}
+
+		addr, err := b.allocateFrom(subnet, id)
+		if err != nil {
+			b.release(id)
+
+			return nil, err
+		}
+
+		res = append(res, addr)
+	}
+
+	return res, nil
+}
+
+// allocateFrom reserves the first free address of the subnet by creating its
+// allocation file exclusively.
+func (b *staticBackend) allocateFrom(subnet netip.Prefix, id string) (netip.Addr, error) {
+	// The first address is the gateway.
+	for addr := subnet.Addr().Next().Next(); subnet.Contains(addr); addr = addr.Next() {
+		// Skip the IPv4 broadcast address.
+		if addr.Is4() && !subnet.Contains(addr.Next()) {
+			break
+		}
+
+		f, err := os.OpenFile(b.allocationPath(addr), os.O_RDWR|os.O_CREATE|os.O_EXCL, 0o600)
+		if errors.Is(err, fs.ErrExist) {
+			continue
+		}
+
+		if err != nil {
+			return netip.Addr{}, err
+		}
+
+		_, err = f.WriteString(id)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+
+		if err != nil {
+			return netip.Addr{}, errors.Join(err, os.Remove(b.allocationPath(addr)))
+		}
+
+		return addr, nil
+	}
+
+	return netip.Addr{}, fmt.Errorf("no free address left in subnet %s", subnet)
+}
+
+// release removes all address allocations of the pod.
+func (b *staticBackend) release(id string) {
+	allocations, err := b.allocations()
+	if err != nil {
+		logrus.Warnf("Unable to release static network addresses of pod %s: %v", id, err)
+
+		return
+	}
+
+	for _, addr := range allocations[id] {
+		if err := os.Remove(b.allocationPath(addr)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			logrus.Warnf("Unable to release static network address %s of pod %s: %v", addr, id, err)
+		}
+	}
+}
+
+// allocations returns the allocated addresses per pod ID.
+func (b *staticBackend) allocations() (map[string][]netip.Addr, error) {
+	entries, err := os.ReadDir(b.config.StateDir)
+	if err != nil {
+		return nil, fmt.Errorf("read static network state: %w", err)
+	}
+
+	res := map[string][]netip.Addr{}
+
+	for _, entry := range entries {
+		addr, err := netip.ParseAddr(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		id, err := os.ReadFile(filepath.Join(b.config.StateDir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("read static network allocation: %w", err)
+		}
+
+		podID := strings.TrimSpace(string(id))
+		res[podID] = append(res[podID], addr)
+	}
+
+	return res, nil
+}
+
+func (b *staticBackend) allocationPath(addr netip.Addr) string {
+	return filepath.Join(b.config.StateDir, addr.String())
+}
+
+// result returns the network result of the pod for the provided addresses.
+func (b *staticBackend) result(network ocicni.PodNetwork, addrs []netip.Addr) *cnicurrent.Result {
+	podInterface := 2
+	result := &cnicurrent.Result{
+		CNIVersion: cnicurrent.ImplementedSpecVersion,
+		Interfaces: []*cnicurrent.Interface{
+			{Name: b.config.Bridge},
+			{Name: hostInterfaceName(network.ID)},
+			{Name: podInterfaceName, Sandbox: network.NetNS},
+		},
+	}
+
+	for _, addr := range addrs {
+		subnet := b.subnets[slices.IndexFunc(b.subnets, func(subnet netip.Prefix) bool {
+			return subnet.Contains(addr)
+		})]
+		gateway := net.IP(subnet.Addr().Next().AsSlice())
+
+		result.IPs = append(result.IPs, &cnicurrent.IPConfig{
+			Interface: &podInterface,
+			Address: net.IPNet{
+				IP:   net.IP(addr.AsSlice()),
+				Mask: net.CIDRMask(subnet.Bits(), addr.BitLen()),
+			},
+			Gateway: gateway,
+		})
+
+		defaultRoute := net.IPNet{IP: net.IPv4zero, Mask: net.CIDRMask(0, 32)}
+		if addr.Is6() {
+			defaultRoute = net.IPNet{IP: net.IPv6zero, Mask: net.CIDRMask(0, 128)}
+		}
+
+		result.Routes = append(result.Routes, &cnitypes.Route{Dst: defaultRoute, GW: gateway})
+	}
+
+	return result
+}
+
+// hostInterfaceName returns the name of the host side veth interface of a
+// pod, which must not exceed 15 characters.
+func hostInterfaceName(id string) string {
+	hash := sha256.Sum256([]byte(id))
+
+	return hostInterfacePrefix + hex.EncodeToString(hash[:])[:11]
+}
@@ -0,0 +1,162 @@
+//go:build linux
+
+package netbackend
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	cnitypes "github.com/containernetworking/cni/pkg/types"
+	cnicurrent "github.com/containernetworking/cni/pkg/types/100"
+	"github.com/containernetworking/plugins/pkg/ns"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// netlinkLinks manages the pod interfaces of the static network backend by
+// using netlink.
+type netlinkLinks struct {
+	bridge string
+}
+
+func newStaticLinks(bridge string) staticLinks {
+	return &netlinkLinks{bridge: bridge}
+}
+
+func (l *netlinkLinks) setUp(netnsPath, hostInterface string, result *cnicurrent.Result) error {
+	bridge, err := l.ensureBridge(result)
+	if err != nil {
+		return fmt.Errorf("ensure bridge %s: %w", l.bridge, err)
+	}
+
+	netns, err := ns.GetNS(netnsPath)
+	if err != nil {
+		return fmt.Errorf("open network namespace: %w", err)
+	}
+	defer netns.Close()
+
+	// Remove the leftovers of a previous attempt.
+	if err := l.tearDown(hostInterface); err != nil {
+		return err
+	}
+
+	veth := &netlink.Veth{
+		LinkAttrs: netlink.LinkAttrs{
+			Name:        hostInterface,
+			MasterIndex: bridge.Attrs().Index,
+		},
+		PeerName:      podInterfaceName,
+		PeerNamespace: netlink.NsFd(int(netns.Fd())),
+	}
+	if err := netlink.LinkAdd(veth); err != nil {
+		return fmt.Errorf("add veth pair %s: %w", hostInterface, err)
+	}
+
+	if err := netlink.LinkSetUp(veth); err != nil {
+		return fmt.Errorf("set veth %s up: %w", hostInterface, err)
+	}
+
+	return netns.Do(func(ns.NetNS) error {
+		return configurePodInterface(result)
+	})
+}
+
+func (l *netlinkLinks) tearDown(hostInterface string) error {
+	link, err := netlink.LinkByName(hostInterface)
+	if err != nil {
+		if errors.As(err, &netlink.LinkNotFoundError{}) {
+			return nil
+		}
+
+		return fmt.Errorf("get veth %s: %w", hostInterface, err)
+	}
+
+	// Removing one end of the pair also removes the other one.
+	if err := netlink.LinkDel(link); err != nil {
+		return fmt.Errorf("delete veth %s: %w", hostInterface, err)
+	}
+
+	return nil
+}
+
+// ensureBridge creates the bridge if it does not exist and assigns the
+// gateway addresses of the result to it.
+func (l *netlinkLinks) ensureBridge(result *cnicurrent.Result) (netlink.Link, error) {
+	bridge, err := netlink.LinkByName(l.bridge)
+	if err != nil {
+		if !errors.As(err, &netlink.LinkNotFoundError{}) {
+			return nil, err
+		}
+
+		if err := netlink.LinkAdd(&netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: l.bridge}}); err != nil && !errors.Is(err, unix.EEXIST) {
+			return nil, fmt.Errorf("add bridge: %w", err)
+		}
+
+		if bridge, err = netlink.LinkByName(l.bridge); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, ip := range result.IPs {
+		addr := &netlink.Addr{IPNet: &net.IPNet{IP: ip.Gateway, Mask: ip.Address.Mask}}
+		if err := netlink.AddrReplace(bridge, addr); err != nil {
+			return nil, fmt.Errorf("add gateway address %s: %w", addr.IPNet, err)
+		}
+	}
+
+	if err := netlink.LinkSetUp(bridge); err != nil {
+		return nil, fmt.Errorf("set bridge up: %w", err)
+	}
+
+	return bridge, nil
+}
+
+// configurePodInterface assigns the addresses and routes of the result to the
+// pod interface and sets it up. It has to be called inside of the network
+// namespace of the pod.
+func configurePodInterface(result *cnicurrent.Result) error {
+	if lo, err := netlink.LinkByName("lo"); err == nil {
+		if err := netlink.LinkSetUp(lo); err != nil {
+			return fmt.Errorf("set loopback up: %w", err)
+		}
+	}
+
+	link, err := netlink.LinkByName(podInterfaceName)
+	if err != nil {
+		return fmt.Errorf("get pod interface: %w", err)
+	}
+
+	for _, ip := range result.IPs {
+		addr := &netlink.Addr{IPNet: &ip.Address}
+		if ip.Address.IP.To4() == nil {
+			// Skip the duplicate address detection to be able to add the
+			// default route via the gateway right away.
+			addr.Flags = unix.IFA_F_NODAD
+		}
+
+		if err := netlink.AddrAdd(link, addr); err != nil {
+			return fmt.Errorf("add address %s: %w", ip.Address.String(), err)
+		}
+	}
+
+	if err := netlink.LinkSetUp(link); err != nil {
+		return fmt.Errorf("set pod interface up: %w", err)
+	}
+
+	for _, route := range result.Routes {
+		if err := addRoute(link, route); err != nil {
+			return fmt.Errorf("add route to %s: %w", route.Dst.String(), err)
+		}
+	}
+
+	return nil
+}
+
+func addRoute(link netlink.Link, route *cnitypes.Route) error {
+	return netlink.RouteAdd(&netlink.Route{
+		LinkIndex: link.Attrs().Index,
+		Dst:       &route.Dst,
+		Gw:        route.GW,
+	})
+}
@@ -0,0 +1,213 @@
+package netbackend_test
+
+import (
+	"context"
+	"errors"
+
+	cnicurrent "github.com/containernetworking/cni/pkg/types/100"
+	"github.com/cri-o/ocicni/pkg/ocicni"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cri-o/cri-o/internal/config/netbackend"
+)
+
+var _ = t.Describe("Static", func() {
+	var (
+		sut        netbackend.Backend
+		setUpErr   error
+		interfaces map[string]string
+	)
+
+	podNetwork := func(id string) ocicni.PodNetwork {
+		return ocicni.PodNetwork{ID: id, NetNS: "/var/run/netns/" + id}
+	}
+
+	podIPs := func(id string) []string {
+		res, err := sut.Status(context.Background(), podNetwork(id))
+		Expect(err).NotTo(HaveOccurred())
+
+		result, err := cnicurrent.GetResult(res)
+		Expect(err).NotTo(HaveOccurred())
+
+		ips := []string{}
+		for _, ip := range result.IPs {
+			ips = append(ips, ip.Address.String())
+		}
+
+		return ips
+	}
+
+	newBackend := func(subnets ...string) {
+		var err error
+
+		sut, err = netbackend.NewStatic(&netbackend.StaticConfig{
+			Subnets:  subnets,
+			Bridge:   netbackend.DefaultStaticBridge,
+			StateDir: t.MustTempDir("static-network"),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		netbackend.SetStaticLinks(sut,
+			func(netnsPath, hostInterface string, _ *cnicurrent.Result) error {
+				if setUpErr != nil {
+					return setUpErr
+				}
+
+				interfaces[hostInterface] = netnsPath
+
+				return nil
+			},
+			func(hostInterface string) error {
+				delete(interfaces, hostInterface)
+
+				return nil
+			},
+		)
+	}
+
+	BeforeEach(func() {
+		setUpErr = nil
+		interfaces = map[string]string{}
+
+		newBackend("10.88.0.0/29", "fd00:88::/120")
+	})
+
+	It("should allocate addresses from all subnets", func() {
+		// When
+		err := sut.SetUpPod(context.Background(), podNetwork("pod1"))
+
+		// Then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(podIPs("pod1")).To(Equal([]string{"10.88.0.2/29", "fd00:88::2/120"}))
+		Expect(interfaces).To(HaveLen(1))
+	})
+
+	It("should return the result of the pod", func() {
+		// Given
+		Expect(sut.SetUpPod(context.Background(), podNetwork("pod1"))).To(Succeed())
+
+		// When
+		res, err := sut.Status(context.Background(), podNetwork("pod1"))
+
+		// Then
+		Expect(err).NotTo(HaveOccurred())
+		result, err := cnicurrent.GetResult(res)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Interfaces).To(HaveLen(3))
+		Expect(result.Interfaces[2].Sandbox).To(Equal("/var/run/netns/pod1"))
+		Expect(result.IPs[0].Gateway.String()).To(Equal("10.88.0.1"))
+		Expect(result.IPs[1].Gateway.String()).To(Equal("fd00:88::1"))
+		Expect(result.Routes).To(HaveLen(2))
+		Expect(result.Routes[0].Dst.String()).To(Equal("0.0.0.0/0"))
+		Expect(result.Routes[1].Dst.String()).To(Equal("::/0"))
+	})
+
+	It("should allocate different addresses for different pods", func() {
+		// Given
+		Expect(sut.SetUpPod(context.Background(), podNetwork("pod1"))).To(Succeed())
+
+		// When
+		err := sut.SetUpPod(context.Background(), podNetwork("pod2"))
+
+		// Then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(podIPs("pod2")).To(Equal([]string{"10.88.0.3/29", "fd00:88::3/120"}))
+		Expect(interfaces).To(HaveLen(2))
+	})
+
+	It("should reuse the addresses when setting up a pod again", func() {
+		// Given
+		Expect(sut.SetUpPod(context.Background(), podNetwork("pod1"))).To(Succeed())
+
+		// When
+		err := sut.SetUpPod(context.Background(), podNetwork("pod1"))
+
+		// Then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(podIPs("pod1")).To(Equal([]string{"10.88.0.2/29", "fd00:88::2/120"}))
+	})
+
+	It("should release the addresses if the setup fails", func() {
+		// Given
+		setUpErr = errors.New("setup failed")
+
+		// When
+		err := sut.SetUpPod(context.Background(), podNetwork("pod1"))
+
+		// Then
+		Expect(err).To(HaveOccurred())
+		_, err = sut.Status(context.Background(), podNetwork("pod1"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should release the addresses on teardown", func() {
+		// Given
+		Expect(sut.SetUpPod(context.Background(), podNetwork("pod1"))).To(Succeed())
+
+		// When
+		err := sut.TearDownPod(context.Background(), podNetwork("pod1"))
+
+		// Then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(interfaces).To(BeEmpty())
+		Expect(sut.SetUpPod(context.Background(), podNetwork("pod2"))).To(Succeed())
+		Expect(podIPs("pod2")).To(Equal([]string{"10.88.0.2/29", "fd00:88::2/120"}))
+	})
+
+	It("should succeed to tear down a pod without addresses", func() {
+		// When
+		err := sut.TearDownPod(context.Background(), podNetwork("pod1"))
+
+		// Then
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should fail if the subnet is exhausted", func() {
+		// Given
+		newBackend("10.88.0.0/30")
+		Expect(sut.SetUpPod(context.Background(), podNetwork("pod1"))).To(Succeed())
+
+		// When
+		err := sut.SetUpPod(context.Background(), podNetwork("pod2"))
+
+		// Then
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("no free address left"))
+	})
+
+	It("should release the addresses of stale pods on GC", func() {
+		// Given
+		Expect(sut.SetUpPod(context.Background(), podNetwork("pod1"))).To(Succeed())
+		Expect(sut.SetUpPod(context.Background(), podNetwork("pod2"))).To(Succeed())
+
+		// When
+		err := sut.GC(context.Background(), func() ([]*ocicni.PodNetwork, error) {
+			pod := podNetwork("pod2")
+
+			return []*ocicni.PodNetwork{&pod}, nil
+		})
+
+		// Then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(interfaces).To(HaveLen(1))
+		_, err = sut.Status(context.Background(), podNetwork("pod1"))
+		Expect(err).To(HaveOccurred())
+		Expect(podIPs("pod2")).To(Equal([]string{"10.88.0.3/29", "fd00:88::3/120"}))
+	})
+
+	DescribeTable("should fail to validate",
+		func(config *netbackend.StaticConfig) {
+			// When
+			_, err := config.Validate()
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("without subnets", &netbackend.StaticConfig{Bridge: "crio0"}),
+		Entry("with an invalid subnet", &netbackend.StaticConfig{Subnets: []string{"10.88.0.0"}, Bridge: "crio0"}),
+		Entry("with a too small subnet", &netbackend.StaticConfig{Subnets: []string{"10.88.0.0/31"}, Bridge: "crio0"}),
+		Entry("with two subnets of a family", &netbackend.StaticConfig{Subnets: []string{"10.88.0.0/16", "10.89.0.0/16"}, Bridge: "crio0"}),
+		Entry("without a bridge", &netbackend.StaticConfig{Subnets: []string{"10.88.0.0/16"}}),
+	)
+})
@@ -0,0 +1,34 @@
+//go:build test
+
+// All *_inject.go files are meant to be used by tests only. Purpose of this
+// files is to provide a way to inject mocked data into the current setup.
+
+package netbackend
+
+import (
+	cnicurrent "github.com/containernetworking/cni/pkg/types/100"
+)
+
+// funcLinks implements the staticLinks by using the provided functions.
+type funcLinks struct {
+	setUpFunc    func(netnsPath, hostInterface string, result *cnicurrent.Result) error
+	tearDownFunc func(hostInterface string) error
+}
+
+func (l *funcLinks) setUp(netnsPath, hostInterface string, result *cnicurrent.Result) error {
+	return l.setUpFunc(netnsPath, hostInterface, result)
+}
+
+func (l *funcLinks) tearDown(hostInterface string) error {
+	return l.tearDownFunc(hostInterface)
+}
+
+// SetStaticLinks replaces the interface management of a static network
+// backend with the provided functions.
+func SetStaticLinks(
+	backend Backend,
+	setUp func(netnsPath, hostInterface string, result *cnicurrent.Result) error,
+	tearDown func(hostInterface string) error,
+) {
+	backend.(*staticBackend).links = &funcLinks{setUpFunc: setUp, tearDownFunc: tearDown}
+}
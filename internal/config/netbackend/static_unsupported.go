@@ -0,0 +1,26 @@
+//go:build !linux
+
+package netbackend
+
+import (
+	"fmt"
+	"runtime"
+
+	cnicurrent "github.com/containernetworking/cni/pkg/types/100"
+)
+
+// unsupportedLinks is used on platforms without support for the static
+// network backend.
+type unsupportedLinks struct{}
+
+func newStaticLinks(string) staticLinks {
+	return &unsupportedLinks{}
+}
+
+func (*unsupportedLinks) setUp(string, string, *cnicurrent.Result) error {
+	return fmt.Errorf("static network backend unsupported on %s", runtime.GOOS)
+}
+
+func (*unsupportedLinks) tearDown(string) error {
+	return nil
+}
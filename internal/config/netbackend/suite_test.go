@@ -0,0 +1,27 @@
+package netbackend_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/cri-o/cri-o/test/framework"
+)
+
+// TestNetBackend runs the created specs.
+func TestNetBackend(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunFrameworkSpecs(t, "NetBackend")
+}
+
+var t *TestFramework
+
+var _ = BeforeSuite(func() {
+	t = NewTestFramework(NilFunc, NilFunc)
+	t.Setup()
+})
+
+var _ = AfterSuite(func() {
+	t.Teardown()
+})
@@ -448,6 +448,10 @@ func mergeRuntimeConfig(config *libconfig.Config, ctx *cli.Context) error {
 		config.DisableHostPortMapping = ctx.Bool("disable-hostport-mapping")
 	}
 
+	if ctx.IsSet("hostport-ipv6-direct-routing") {
+		config.HostPortIPv6DirectRouting = ctx.Bool("hostport-ipv6-direct-routing")
+	}
+
 	// Timezone
 	if ctx.IsSet("timezone") {
 		config.Timezone = ctx.String("timezone")
@@ -519,6 +523,22 @@ func mergeNetworkConfig(config *libconfig.Config, ctx *cli.Context) {
 	if ctx.IsSet("cni-plugin-dir") {
 		config.PluginDirs = StringSliceTrySplit(ctx, "cni-plugin-dir")
 	}
+
+	if ctx.IsSet("network-backend") {
+		config.NetworkBackendName = ctx.String("network-backend")
+	}
+
+	if ctx.IsSet("static-network-subnets") {
+		config.StaticNetworkSubnets = StringSliceTrySplit(ctx, "static-network-subnets")
+	}
+
+	if ctx.IsSet("static-network-bridge") {
+		config.StaticNetworkBridge = ctx.String("static-network-bridge")
+	}
+
+	if ctx.IsSet("bandwidth-shaping") {
+		config.BandwidthShaping = ctx.String("bandwidth-shaping")
+	}
 }
 
 // mergeAPIConfig merges APIConfig-related CLI flags into the config, including gRPC and streaming settings.
@@ -611,6 +631,10 @@ func mergeMetricsConfig(config *libconfig.Config, ctx *cli.Context) {
 		config.CollectionPeriod = ctx.Int("collection-period")
 	}
 
+	if ctx.IsSet("stats-cache-ttl") {
+		config.StatsCacheTTL = ctx.Int("stats-cache-ttl")
+	}
+
 	if ctx.IsSet("included-pod-metrics") {
 		config.IncludedPodMetrics = StringSliceTrySplit(ctx, "included-pod-metrics")
 	}
@@ -1008,6 +1032,30 @@ func getCrioFlags(defConf *libconfig.Config) []cli.Flag {
 			Usage:   "CNI plugin binaries directory.",
 			EnvVars: []string{"CONTAINER_CNI_PLUGIN_DIR"},
 		},
+		&cli.StringFlag{
+			Name:    "network-backend",
+			Usage:   "Backend used to set up the pod network ('cni' or 'static').",
+			Value:   defConf.NetworkBackendName,
+			EnvVars: []string{"CONTAINER_NETWORK_BACKEND"},
+		},
+		&cli.StringSliceFlag{
+			Name:    "static-network-subnets",
+			Value:   cli.NewStringSlice(defConf.StaticNetworkSubnets...),
+			Usage:   "Subnets the static network backend assigns the pod addresses from, at most one per IP family.",
+			EnvVars: []string{"CONTAINER_STATIC_NETWORK_SUBNETS"},
+		},
+		&cli.StringFlag{
+			Name:    "static-network-bridge",
+			Usage:   "Bridge the static network backend attaches the pods to.",
+			Value:   defConf.StaticNetworkBridge,
+			EnvVars: []string{"CONTAINER_STATIC_NETWORK_BRIDGE"},
+		},
+		&cli.StringFlag{
+			Name:    "bandwidth-shaping",
+			Usage:   "The way the pod bandwidth annotations are applied: 'cni' passes them to the CNI bandwidth plugin, 'native' shapes the traffic by using tc.",
+			Value:   defConf.BandwidthShaping,
+			EnvVars: []string{"CONTAINER_BANDWIDTH_SHAPING"},
+		},
 		&cli.StringFlag{
 			Name:  "image-volumes",
 			Value: string(libconfig.ImageVolumesMkdir),
@@ -1509,6 +1557,12 @@ func getCrioFlags(defConf *libconfig.Config) []cli.Flag {
 			Usage:   "The number of seconds between collecting pod/container stats and pod sandbox metrics. If set to 0, the metrics/stats are collected on-demand instead.",
 			EnvVars: []string{"COLLECTION_PERIOD"},
 		},
+		&cli.IntFlag{
+			Name:    "stats-cache-ttl",
+			Value:   defConf.StatsCacheTTL,
+			Usage:   "The number of seconds collected pod/container stats and pod sandbox metrics are cached per sandbox and container. If set to a value greater than 0, the stats are collected on-demand only and the collection period is ignored.",
+			EnvVars: []string{"CONTAINER_STATS_CACHE_TTL"},
+		},
 		&cli.StringSliceFlag{
 			Name:  "included-pod-metrics",
 			Usage: "A list of pod metrics to include. Specify the names of the metrics to include in this list.",
@@ -1552,6 +1606,12 @@ func getCrioFlags(defConf *libconfig.Config) []cli.Flag {
 			EnvVars: []string{"DISABLE_HOSTPORT_MAPPING"},
 			Value:   defConf.DisableHostPortMapping,
 		},
+		&cli.BoolFlag{
+			Name:    "hostport-ipv6-direct-routing",
+			Usage:   "If true, CRI-O reserves the hostports of IPv6 pods without installing NAT66 rules, because the pod addresses are routed directly.",
+			EnvVars: []string{"CONTAINER_HOSTPORT_IPV6_DIRECT_ROUTING"},
+			Value:   defConf.HostPortIPv6DirectRouting,
+		},
 		&cli.StringFlag{
 			Name:    "timezone",
 			Aliases: []string{"tz"},
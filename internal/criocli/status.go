@@ -3,18 +3,22 @@ package criocli
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 
 	"github.com/cri-o/cri-o/internal/client"
+	"github.com/cri-o/cri-o/pkg/types"
 )
 
 const (
 	defaultSocket = "/var/run/crio/crio.sock"
 	idArg         = "id"
 	socketArg     = "socket"
+	terminateArg  = "terminate"
 )
 
 var StatusCommand = &cli.Command{
@@ -68,6 +72,33 @@ var StatusCommand = &cli.Command{
 				TakesFile: true,
 			},
 		},
+	}, {
+		Action:  sessions,
+		Aliases: []string{"ss"},
+		Name:    "sessions",
+		Usage:   "Display the active exec and attach sessions or terminate one of them.",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    terminateArg,
+				Aliases: []string{"t"},
+				Usage:   "the ID of the session to terminate",
+			},
+		},
+	}, {
+		Action:  pressure,
+		Aliases: []string{"p"},
+		Name:    "pressure",
+		Usage:   "Follow the events of containers crossing the configured pressure stall thresholds.",
+	}, {
+		Action:  hostports,
+		Aliases: []string{"hostport"},
+		Name:    "hostports",
+		Usage:   "Display the host ports reserved by pod sandboxes.",
+	}, {
+		Action:  network,
+		Aliases: []string{"n"},
+		Name:    "network",
+		Usage:   "Display diagnostic information about the pod network, such as the discovered CNI configuration files and plugin binaries.",
 	}},
 }
 
@@ -135,6 +166,22 @@ func containers(c *cli.Context) error {
 	fmt.Printf("sandbox: %s\n", info.Sandbox)
 	fmt.Printf("ips: %s\n", strings.Join(info.IPs, ", "))
 
+	if f := info.OOMForensics; f != nil {
+		fmt.Printf("OOM forensics captured: %v\n", time.Unix(0, f.CapturedAt))
+		fmt.Printf("  OOM kills: %d\n", f.MemoryEvents["oom_kill"])
+		fmt.Printf("  limits:\n")
+
+		for k, v := range f.Limits {
+			fmt.Printf("    %s: %s\n", k, v)
+		}
+
+		fmt.Printf("  top processes (format <pid> <command> <rss bytes>):\n")
+
+		for _, p := range f.TopProcesses {
+			fmt.Printf("    %d %s %d\n", p.Pid, p.Command, p.RSSBytes)
+		}
+	}
+
 	return nil
 }
 
@@ -223,3 +270,144 @@ func heap(c *cli.Context) error {
 
 	return nil
 }
+
+func sessions(c *cli.Context) error {
+	crioClient, err := crioClient(c)
+	if err != nil {
+		return err
+	}
+
+	if id := c.String(terminateArg); id != "" {
+		if err := crioClient.TerminateSession(c.Context, id); err != nil {
+			return err
+		}
+
+		fmt.Printf("terminated session %s\n", id)
+
+		return nil
+	}
+
+	sessions, err := crioClient.SessionsInfo(c.Context)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range sessions {
+		fmt.Printf("id: %s\n", s.ID)
+		fmt.Printf("  type: %s\n", s.Type)
+		fmt.Printf("  container: %s\n", s.ContainerID)
+		fmt.Printf("  command: %s\n", strings.Join(s.Command, " "))
+		fmt.Printf("  tty: %t\n", s.TTY)
+		fmt.Printf("  client: %s\n", s.Client)
+		fmt.Printf("  started: %v\n", time.Unix(0, s.StartedTime))
+		fmt.Printf("  last activity: %v\n", time.Unix(0, s.LastActivity))
+	}
+
+	return nil
+}
+
+func pressure(c *cli.Context) error {
+	crioClient, err := crioClient(c)
+	if err != nil {
+		return err
+	}
+
+	return crioClient.PressureEvents(c.Context, func(e *types.PressureEvent) {
+		fmt.Printf("%v %s: container %s (%s) of pod sandbox %s: %s %s avg10 %.2f, threshold %.2f\n",
+			time.Unix(0, e.CreatedAt), e.EventType, e.ContainerName, e.ContainerID, e.PodSandboxID,
+			e.Resource, e.Kind, e.Avg10, e.Threshold)
+	})
+}
+
+func hostports(c *cli.Context) error {
+	crioClient, err := crioClient(c)
+	if err != nil {
+		return err
+	}
+
+	reservations, err := crioClient.HostPortReservations(c.Context)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range reservations {
+		hostIP := r.HostIP
+		if hostIP == "" {
+			hostIP = "*"
+		}
+
+		hostPort := strconv.Itoa(int(r.HostPort))
+		if r.HostPortEnd > r.HostPort {
+			hostPort += "-" + strconv.Itoa(int(r.HostPortEnd))
+		}
+
+		fmt.Printf("%s %s:%s/%s: pod sandbox %s (%s)\n",
+			r.Family, hostIP, hostPort, r.Protocol, r.PodSandboxName, r.PodSandboxID)
+	}
+
+	return nil
+}
+
+func network(c *cli.Context) error {
+	crioClient, err := crioClient(c)
+	if err != nil {
+		return err
+	}
+
+	status, err := crioClient.NetworkStatus(c.Context)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("backend: %s\n", status.Backend)
+	fmt.Printf("ready: %v\n", status.Ready)
+
+	if status.Error != "" {
+		fmt.Printf("error: %s\n", status.Error)
+	}
+
+	if status.LastPoll != 0 {
+		fmt.Printf("last poll: %v\n", time.Unix(0, status.LastPoll))
+	}
+
+	fmt.Printf("default network: %s\n", status.DefaultNetwork)
+
+	if status.NetworkDir != "" {
+		fmt.Printf("config files in %s:\n", status.NetworkDir)
+	}
+
+	for _, f := range status.ConfigFiles {
+		if f.Error != "" {
+			fmt.Printf("  %s: error: %s\n", f.Path, f.Error)
+
+			continue
+		}
+
+		fmt.Printf("  %s: network %s, plugins %s\n", f.Path, f.Network, strings.Join(f.Plugins, ","))
+	}
+
+	for _, d := range status.PluginDirs {
+		if d.Error != "" {
+			fmt.Printf("plugin dir %s: error: %s\n", d.Path, d.Error)
+
+			continue
+		}
+
+		fmt.Printf("plugin dir %s: %s\n", d.Path, strings.Join(d.Binaries, ","))
+	}
+
+	if len(status.MissingPlugins) > 0 {
+		fmt.Printf("missing plugins: %s\n", strings.Join(status.MissingPlugins, ","))
+	}
+
+	if status.GC != nil {
+		result := "ok"
+		if status.GC.Error != "" {
+			result = "error: " + status.GC.Error
+		}
+
+		fmt.Printf("last gc: %v, %d valid pods, %s\n", time.Unix(0, status.GC.Time), status.GC.ValidPods, result)
+	}
+
+	return nil
+}
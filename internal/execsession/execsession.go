@@ -0,0 +1,362 @@
+package execsession
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.podman.io/storage/pkg/stringid"
+
+	"github.com/cri-o/cri-o/internal/log"
+	"github.com/cri-o/cri-o/pkg/types"
+)
+
+// Type is the kind of a streaming session.
+type Type string
+
+const (
+	// TypeExec is a session created by an exec request.
+	TypeExec Type = "exec"
+
+	// TypeAttach is a session created by an attach request.
+	TypeAttach Type = "attach"
+)
+
+const (
+	// pendingClientTTL is how long a client recorded by ExpectClient can be
+	// claimed by a new session. It matches the lifetime of the streaming
+	// server's request token cache.
+	pendingClientTTL = time.Minute
+
+	// maxPendingClients is the maximum number of clients recorded per
+	// container which have not yet been claimed by a session.
+	maxPendingClients = 16
+
+	// minReapInterval and maxReapInterval are the bounds of the idle session
+	// check interval.
+	minReapInterval = 10 * time.Millisecond
+	maxReapInterval = 30 * time.Second
+)
+
+// ErrNotFound is returned if a session does not exist in the registry.
+var ErrNotFound = errors.New("stream session not found")
+
+// Registry keeps track of all active exec and attach sessions.
+type Registry struct {
+	mu          sync.Mutex
+	sessions    map[string]*Session
+	pending     map[string][]pendingClient
+	idleTimeout time.Duration
+}
+
+type pendingClient struct {
+	client  string
+	created time.Time
+}
+
+// Session is a single active exec or attach session.
+type Session struct {
+	id          string
+	containerID string
+	sessionType Type
+	command     []string
+	tty         bool
+	client      string
+	started     time.Time
+
+	lastActivity atomic.Int64
+	cancel       context.CancelFunc
+
+	closersLock sync.Mutex
+	closers     []io.Closer
+	terminated  atomic.Bool
+}
+
+// New creates a new session registry. Sessions without any stream activity
+// for longer than idleTimeout get terminated by Run. An idleTimeout of zero
+// disables the idle check.
+func New(idleTimeout time.Duration) *Registry {
+	return &Registry{
+		sessions:    map[string]*Session{},
+		pending:     map[string][]pendingClient{},
+		idleTimeout: idleTimeout,
+	}
+}
+
+// ExpectClient records the client which requested a streaming URL for the
+// provided container or pod sandbox. The next session registered or client
+// claimed for that ID will be attributed to it, because the streaming server
+// does not forward any client information on its own.
+func (r *Registry) ExpectClient(containerID, client string) {
+	if client == "" {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	clients := append(r.pending[containerID], pendingClient{client: client, created: time.Now()})
+	if len(clients) > maxPendingClients {
+		clients = clients[len(clients)-maxPendingClients:]
+	}
+
+	r.pending[containerID] = clients
+}
+
+// ClaimClient returns the oldest unexpired client recorded for the ID by
+// ExpectClient, or an empty string if there is none. It is used by streams
+// which are not registered as sessions, like port forwards.
+func (r *Registry) ClaimClient(id string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.claimClient(id)
+}
+
+// claimClient returns the oldest unexpired client recorded for the container.
+// It has to be called with the registry lock held.
+func (r *Registry) claimClient(containerID string) string {
+	clients := r.pending[containerID]
+	for len(clients) > 0 {
+		next := clients[0]
+		clients = clients[1:]
+
+		if time.Since(next.created) <= pendingClientTTL {
+			r.setPending(containerID, clients)
+
+			return next.client
+		}
+	}
+
+	r.setPending(containerID, clients)
+
+	return ""
+}
+
+func (r *Registry) setPending(containerID string, clients []pendingClient) {
+	if len(clients) == 0 {
+		delete(r.pending, containerID)
+
+		return
+	}
+
+	r.pending[containerID] = clients
+}
+
+// Register adds a new session to the registry. The returned context is
+// canceled once the session gets terminated and should be used for the
+// underlying runtime operation. Callers have to call Unregister after the
+// session finished.
+func (r *Registry) Register(ctx context.Context, containerID string, sessionType Type, command []string, tty bool) (context.Context, *Session) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	now := time.Now()
+	s := &Session{
+		id:          stringid.GenerateNonCryptoID(),
+		containerID: containerID,
+		sessionType: sessionType,
+		command:     slices.Clone(command),
+		tty:         tty,
+		started:     now,
+		cancel:      cancel,
+	}
+	s.lastActivity.Store(now.UnixNano())
+
+	r.mu.Lock()
+	s.client = r.claimClient(containerID)
+	r.sessions[s.id] = s
+	r.mu.Unlock()
+
+	log.Debugf(ctx, "Registered %s session %s for container %s", sessionType, s.id, containerID)
+
+	return ctx, s
+}
+
+// Unregister removes the session from the registry and releases its context.
+func (r *Registry) Unregister(s *Session) {
+	r.mu.Lock()
+	delete(r.sessions, s.id)
+	r.mu.Unlock()
+
+	s.cancel()
+}
+
+// List returns information about all active sessions, ordered by their start
+// time.
+func (r *Registry) List() []types.StreamSessionInfo {
+	r.mu.Lock()
+	sessions := make([]*Session, 0, len(r.sessions))
+
+	for _, s := range r.sessions {
+		sessions = append(sessions, s)
+	}
+	r.mu.Unlock()
+
+	slices.SortFunc(sessions, func(a, b *Session) int {
+		return a.started.Compare(b.started)
+	})
+
+	res := make([]types.StreamSessionInfo, 0, len(sessions))
+	for _, s := range sessions {
+		res = append(res, s.Info())
+	}
+
+	return res
+}
+
+// Terminate forcefully ends the session with the provided ID.
+func (r *Registry) Terminate(ctx context.Context, id string) error {
+	r.mu.Lock()
+	s, ok := r.sessions[id]
+	r.mu.Unlock()
+
+	if !ok {
+		return ErrNotFound
+	}
+
+	log.Infof(ctx, "Terminating %s session %s for container %s", s.sessionType, s.id, s.containerID)
+	s.terminate()
+
+	return nil
+}
+
+// Run periodically terminates sessions which exceeded the idle timeout until
+// the context is done. It returns immediately if no idle timeout is configured.
+func (r *Registry) Run(ctx context.Context) {
+	if r.idleTimeout <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(max(min(r.idleTimeout/2, maxReapInterval), minReapInterval))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			r.terminateIdle(ctx, now)
+		}
+	}
+}
+
+func (r *Registry) terminateIdle(ctx context.Context, now time.Time) {
+	r.mu.Lock()
+	idle := []*Session{}
+
+	for _, s := range r.sessions {
+		if now.Sub(s.LastActivity()) > r.idleTimeout {
+			idle = append(idle, s)
+		}
+	}
+	r.mu.Unlock()
+
+	for _, s := range idle {
+		log.Infof(ctx, "Terminating %s session %s for container %s after being idle for more than %s",
+			s.sessionType, s.id, s.containerID, r.idleTimeout)
+		s.terminate()
+	}
+}
+
+// ID returns the unique identifier of the session.
+func (s *Session) ID() string {
+	return s.id
+}
+
+// LastActivity returns the time of the last stream activity of the session.
+func (s *Session) LastActivity() time.Time {
+	return time.Unix(0, s.lastActivity.Load())
+}
+
+// Terminated returns true if the session got forcefully terminated.
+func (s *Session) Terminated() bool {
+	return s.terminated.Load()
+}
+
+// Info returns the inspect representation of the session.
+func (s *Session) Info() types.StreamSessionInfo {
+	return types.StreamSessionInfo{
+		ID:           s.id,
+		ContainerID:  s.containerID,
+		Type:         string(s.sessionType),
+		Command:      s.command,
+		TTY:          s.tty,
+		Client:       s.client,
+		StartedTime:  s.started.UnixNano(),
+		LastActivity: s.lastActivity.Load(),
+	}
+}
+
+// TrackReader wraps the provided reader to record activity on the session.
+func (s *Session) TrackReader(r io.Reader) io.Reader {
+	if r == nil {
+		return nil
+	}
+
+	return &activityReader{Reader: r, session: s}
+}
+
+// TrackWriter wraps the provided writer to record activity on the session.
+// The writer gets closed if the session is terminated.
+func (s *Session) TrackWriter(w io.WriteCloser) io.WriteCloser {
+	if w == nil {
+		return nil
+	}
+
+	s.closersLock.Lock()
+	s.closers = append(s.closers, w)
+	s.closersLock.Unlock()
+
+	return &activityWriter{WriteCloser: w, session: s}
+}
+
+func (s *Session) touch() {
+	s.lastActivity.Store(time.Now().UnixNano())
+}
+
+func (s *Session) terminate() {
+	if !s.terminated.CompareAndSwap(false, true) {
+		return
+	}
+
+	s.cancel()
+
+	s.closersLock.Lock()
+	defer s.closersLock.Unlock()
+
+	for _, c := range s.closers {
+		c.Close()
+	}
+}
+
+type activityReader struct {
+	io.Reader
+	session *Session
+}
+
+func (a *activityReader) Read(p []byte) (int, error) {
+	n, err := a.Reader.Read(p)
+	if n > 0 {
+		a.session.touch()
+	}
+
+	return n, err
+}
+
+type activityWriter struct {
+	io.WriteCloser
+	session *Session
+}
+
+func (a *activityWriter) Write(p []byte) (int, error) {
+	n, err := a.WriteCloser.Write(p)
+	if n > 0 {
+		a.session.touch()
+	}
+
+	return n, err
+}
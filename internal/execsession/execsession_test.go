@@ -0,0 +1,182 @@
+package execsession_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cri-o/cri-o/internal/execsession"
+)
+
+type closeRecorder struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+
+	return nil
+}
+
+// The actual test suite.
+var _ = t.Describe("Registry", func() {
+	const containerID = "container"
+
+	var sut *execsession.Registry
+
+	BeforeEach(func() {
+		sut = execsession.New(0)
+	})
+
+	t.Describe("Register", func() {
+		It("should list registered sessions", func() {
+			// Given
+			sut.ExpectClient(containerID, "kubelet")
+
+			// When
+			_, session := sut.Register(context.Background(), containerID, execsession.TypeExec, []string{"/bin/sh"}, true)
+
+			// Then
+			sessions := sut.List()
+			Expect(sessions).To(HaveLen(1))
+			Expect(sessions[0].ID).To(Equal(session.ID()))
+			Expect(sessions[0].ContainerID).To(Equal(containerID))
+			Expect(sessions[0].Type).To(Equal(string(execsession.TypeExec)))
+			Expect(sessions[0].Command).To(Equal([]string{"/bin/sh"}))
+			Expect(sessions[0].TTY).To(BeTrue())
+			Expect(sessions[0].Client).To(Equal("kubelet"))
+		})
+
+		It("should attribute a client only once", func() {
+			// Given
+			sut.ExpectClient(containerID, "kubelet")
+			sut.Register(context.Background(), containerID, execsession.TypeAttach, nil, false)
+
+			// When
+			_, session := sut.Register(context.Background(), containerID, execsession.TypeAttach, nil, false)
+
+			// Then
+			Expect(session.Info().Client).To(BeEmpty())
+		})
+
+		It("should remove unregistered sessions", func() {
+			// Given
+			ctx, session := sut.Register(context.Background(), containerID, execsession.TypeExec, nil, false)
+
+			// When
+			sut.Unregister(session)
+
+			// Then
+			Expect(sut.List()).To(BeEmpty())
+			Expect(ctx.Err()).To(HaveOccurred())
+			Expect(session.Terminated()).To(BeFalse())
+		})
+	})
+
+	t.Describe("ClaimClient", func() {
+		It("should claim an expected client only once", func() {
+			// Given
+			sut.ExpectClient(containerID, "kubelet")
+
+			// When
+			client := sut.ClaimClient(containerID)
+
+			// Then
+			Expect(client).To(Equal("kubelet"))
+			Expect(sut.ClaimClient(containerID)).To(BeEmpty())
+			Expect(sut.List()).To(BeEmpty())
+		})
+	})
+
+	t.Describe("Terminate", func() {
+		It("should cancel the session and close its streams", func() {
+			// Given
+			ctx, session := sut.Register(context.Background(), containerID, execsession.TypeExec, nil, false)
+			stdout := &closeRecorder{}
+			w := session.TrackWriter(stdout)
+
+			// When
+			err := sut.Terminate(context.Background(), session.ID())
+
+			// Then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ctx.Err()).To(HaveOccurred())
+			Expect(session.Terminated()).To(BeTrue())
+			Expect(stdout.closed).To(BeTrue())
+			Expect(w).NotTo(BeNil())
+		})
+
+		It("should fail if the session does not exist", func() {
+			// When
+			err := sut.Terminate(context.Background(), "unknown")
+
+			// Then
+			Expect(err).To(MatchError(execsession.ErrNotFound))
+		})
+	})
+
+	t.Describe("Track", func() {
+		It("should record stream activity", func() {
+			// Given
+			_, session := sut.Register(context.Background(), containerID, execsession.TypeExec, nil, false)
+			before := session.LastActivity()
+			r := session.TrackReader(bytes.NewBufferString("input"))
+			time.Sleep(time.Millisecond)
+
+			// When
+			_, err := io.ReadAll(r)
+
+			// Then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(session.LastActivity()).To(BeTemporally(">", before))
+		})
+
+		It("should keep nil streams nil", func() {
+			// Given
+			_, session := sut.Register(context.Background(), containerID, execsession.TypeExec, nil, false)
+
+			// When / Then
+			Expect(session.TrackReader(nil)).To(BeNil())
+			Expect(session.TrackWriter(nil)).To(BeNil())
+		})
+	})
+
+	t.Describe("Run", func() {
+		It("should terminate idle sessions", func() {
+			// Given
+			sut = execsession.New(50 * time.Millisecond)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			go sut.Run(ctx)
+
+			// When
+			sessionCtx, session := sut.Register(context.Background(), containerID, execsession.TypeExec, nil, false)
+
+			// Then
+			Eventually(sessionCtx.Done()).Should(BeClosed())
+			Expect(session.Terminated()).To(BeTrue())
+		})
+
+		It("should terminate idle sessions with a tiny idle timeout", func() {
+			// Given
+			sut = execsession.New(time.Nanosecond)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			go sut.Run(ctx)
+
+			// When
+			sessionCtx, session := sut.Register(context.Background(), containerID, execsession.TypeExec, nil, false)
+
+			// Then
+			Eventually(sessionCtx.Done()).Should(BeClosed())
+			Expect(session.Terminated()).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,45 @@
+package hostport
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	utilnet "k8s.io/utils/net"
+)
+
+// ErrHostIPFamilyMismatch is returned if the host IP of a port mapping does
+// not match the IP family of any pod IP.
+var ErrHostIPFamilyMismatch = errors.New("host IP family mismatch")
+
+// ValidateHostIPFamilies returns an error if any of the port mappings has a
+// host IP which is invalid or whose IP family differs from all of the pod IPs,
+// because such a mapping could never be installed.
+func ValidateHostIPFamilies(podIPs []string, hostportMappings []*PortMapping) error {
+	families := map[utilnet.IPFamily]bool{}
+	for _, ip := range podIPs {
+		families[utilnet.IPFamilyOfString(ip)] = true
+	}
+
+	var mismatches []string
+
+	for _, pm := range hostportMappings {
+		if pm.HostPort <= 0 || pm.HostIP == "" {
+			continue
+		}
+
+		family := utilnet.IPFamilyOfString(pm.HostIP)
+		switch {
+		case family == utilnet.IPFamilyUnknown:
+			mismatches = append(mismatches, fmt.Sprintf("%s:%s/%s (invalid host IP)", pm.HostIP, pm.hostPortString("-"), pm.Protocol))
+		case !families[family]:
+			mismatches = append(mismatches, fmt.Sprintf("%s:%s/%s (no IPv%s pod IP)", pm.HostIP, pm.hostPortString("-"), pm.Protocol, family))
+		}
+	}
+
+	if len(mismatches) > 0 {
+		return fmt.Errorf("%w: pod IPs %v do not match the port mappings %s", ErrHostIPFamilyMismatch, podIPs, strings.Join(mismatches, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package hostport
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ = t.Describe("ValidateHostIPFamilies", func() {
+	mapping := func(hostIP string) []*PortMapping {
+		return []*PortMapping{{HostPort: 8080, ContainerPort: 80, Protocol: v1.ProtocolTCP, HostIP: hostIP}}
+	}
+
+	DescribeTable("should validate the host IP families",
+		func(podIPs []string, mappings []*PortMapping, valid bool) {
+			err := ValidateHostIPFamilies(podIPs, mappings)
+			if valid {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(ErrHostIPFamilyMismatch))
+			}
+		},
+		Entry("unspecified host IP on IPv4 pod", []string{"10.1.1.2"}, mapping(""), true),
+		Entry("unspecified host IP on IPv6 pod", []string{"2001:beef::2"}, mapping(""), true),
+		Entry("IPv4 host IP on IPv4 pod", []string{"10.1.1.2"}, mapping("192.168.2.7"), true),
+		Entry("IPv6 host IP on IPv6 pod", []string{"2001:beef::2"}, mapping("2001:beef::1"), true),
+		Entry("IPv6 host IP on IPv4 pod", []string{"10.1.1.2"}, mapping("2001:beef::1"), false),
+		Entry("IPv4 host IP on IPv6 pod", []string{"2001:beef::2"}, mapping("192.168.2.7"), false),
+		Entry("IPv4 host IP on dual-stack pod", []string{"10.1.1.2", "2001:beef::2"}, mapping("192.168.2.7"), true),
+		Entry("IPv6 host IP on dual-stack pod", []string{"10.1.1.2", "2001:beef::2"}, mapping("2001:beef::1"), true),
+		Entry("invalid host IP", []string{"10.1.1.2", "2001:beef::2"}, mapping("invalid"), false),
+		Entry("mismatch without host port", []string{"10.1.1.2"}, []*PortMapping{
+			{ContainerPort: 80, Protocol: v1.ProtocolTCP, HostIP: "2001:beef::1"},
+		}, true),
+	)
+
+	It("should list all mismatching mappings", func() {
+		// When
+		err := ValidateHostIPFamilies([]string{"10.1.1.2"}, []*PortMapping{
+			{HostPort: 8080, ContainerPort: 80, Protocol: v1.ProtocolTCP, HostIP: "2001:beef::1"},
+			{HostPort: 8443, ContainerPort: 443, Protocol: v1.ProtocolTCP, HostIP: "192.168.2.7"},
+			{HostPort: 9000, HostPortEnd: 9010, ContainerPort: 9000, Protocol: v1.ProtocolUDP, HostIP: "2001:beef::1"},
+		})
+
+		// Then
+		Expect(err).To(MatchError(ContainSubstring("2001:beef::1:8080/TCP")))
+		Expect(err).To(MatchError(ContainSubstring("2001:beef::1:9000-9010/UDP")))
+		Expect(err).NotTo(MatchError(ContainSubstring("8443")))
+	})
+})
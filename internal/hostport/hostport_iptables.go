@@ -24,6 +24,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -44,6 +45,32 @@ const (
 	crioMasqueradeChain utiliptables.Chain = "CRIO-HOSTPORTS-MASQ"
 	// prefix for masquerade chains.
 	crioMasqueradeChainPrefix string = "CRIO-MASQ-"
+
+	// the forward chain of directly routed hostports.
+	crioForwardChain utiliptables.Chain = "CRIO-HOSTPORTS-FWD"
+	// prefix for forward chains.
+	crioForwardChainPrefix string = "CRIO-FWD-"
+)
+
+// hostportTableChains are the chains managed in an iptables table, where
+// prefixes are the ones of the per hostport chains.
+type hostportTableChains struct {
+	table    utiliptables.Table
+	chains   []utiliptables.Chain
+	prefixes []string
+}
+
+var (
+	natHostportChains = &hostportTableChains{
+		table:    utiliptables.TableNAT,
+		chains:   []utiliptables.Chain{kubeHostportsChain, crioMasqueradeChain},
+		prefixes: []string{kubeHostportChainPrefix, crioMasqueradeChainPrefix},
+	}
+	filterHostportChains = &hostportTableChains{
+		table:    utiliptables.TableFilter,
+		chains:   []utiliptables.Chain{crioForwardChain},
+		prefixes: []string{crioForwardChainPrefix},
+	}
 )
 
 type hostportManagerIPTables struct {
@@ -77,7 +104,7 @@ func (hm *hostportManagerIPTables) Add(id, name, podIP string, hostportMappings
 
 	writeLine(natChains, "*nat")
 
-	existingChains, existingRules, err := getExistingHostportIPTablesRules(hm.iptables)
+	existingChains, existingRules, err := getExistingHostportIPTablesRules(hm.iptables, natHostportChains)
 	if err != nil {
 		return err
 	}
@@ -86,6 +113,7 @@ func (hm *hostportManagerIPTables) Add(id, name, podIP string, hostportMappings
 
 	for _, pm := range hostportMappings {
 		protocol := strings.ToLower(string(pm.Protocol))
+		comment := fmt.Sprintf(`"%s hostport %s"`, name, pm.hostPortString("-"))
 		hpChain := getHostportChain(kubeHostportChainPrefix, id, pm)
 		masqChain := getHostportChain(crioMasqueradeChainPrefix, id, pm)
 		newChains = append(newChains, hpChain, masqChain)
@@ -97,25 +125,33 @@ func (hm *hostportManagerIPTables) Add(id, name, podIP string, hostportMappings
 		// Prepend the new chains to KUBE-HOSTPORTS and CRIO-HOSTPORTS-MASQ
 		// This avoids any leaking iptables rules that take up the same port
 		writeLine(natRules, "-I", string(kubeHostportsChain),
-			"-m", "comment", "--comment", fmt.Sprintf(`"%s hostport %d"`, name, pm.HostPort),
-			"-m", protocol, "-p", protocol, "--dport", strconv.Itoa(int(pm.HostPort)),
+			"-m", "comment", "--comment", comment,
+			"-m", protocol, "-p", protocol, "--dport", pm.hostPortString(":"),
 			"-j", string(hpChain),
 		)
 		writeLine(natRules, "-I", string(crioMasqueradeChain),
-			"-m", "comment", "--comment", fmt.Sprintf(`"%s hostport %d"`, name, pm.HostPort),
+			"-m", "comment", "--comment", comment,
 			"-j", string(masqChain),
 		)
 
-		// DNAT to the podIP:containerPort
-		hostPortBinding := net.JoinHostPort(podIP, strconv.Itoa(int(pm.ContainerPort)))
+		// DNAT to the podIP:containerPort, port ranges keep the destination
+		// port and therefore only DNAT to the podIP
+		containerPort := strconv.Itoa(int(pm.ContainerPort))
+		hostPortBinding := net.JoinHostPort(podIP, containerPort)
+
+		if pm.IsRange() {
+			hostPortBinding = podIP
+			containerPort = pm.hostPortString(":")
+		}
+
 		if pm.HostIP == "" || pm.HostIP == "0.0.0.0" || pm.HostIP == "::" {
 			writeLine(natRules, "-A", string(hpChain),
-				"-m", "comment", "--comment", fmt.Sprintf(`"%s hostport %d"`, name, pm.HostPort),
+				"-m", "comment", "--comment", comment,
 				"-m", protocol, "-p", protocol,
 				"-j", "DNAT", "--to-destination="+hostPortBinding)
 		} else {
 			writeLine(natRules, "-A", string(hpChain),
-				"-m", "comment", "--comment", fmt.Sprintf(`"%s hostport %d"`, name, pm.HostPort),
+				"-m", "comment", "--comment", comment,
 				"-m", protocol, "-p", protocol, "-d", pm.HostIP,
 				"-j", "DNAT", "--to-destination="+hostPortBinding)
 		}
@@ -126,9 +162,9 @@ func (hm *hostportManagerIPTables) Add(id, name, podIP string, hostportMappings
 		// and has src=dst=podIP then _someone_ needs to masquerade it, and the
 		// worst case here is just that "-j MASQUERADE" gets called twice.
 		writeLine(natRules, "-A", string(masqChain),
-			"-m", "comment", "--comment", fmt.Sprintf(`"%s hostport %d"`, name, pm.HostPort),
-			"-m", "conntrack", "--ctorigdstport", strconv.Itoa(int(pm.HostPort)),
-			"-m", protocol, "-p", protocol, "--dport", strconv.Itoa(int(pm.ContainerPort)),
+			"-m", "comment", "--comment", comment,
+			"-m", "conntrack", "--ctorigdstport", pm.hostPortString(":"),
+			"-m", protocol, "-p", protocol, "--dport", containerPort,
 			"-s", podIP, "-d", podIP,
 			"-j", "MASQUERADE")
 	}
@@ -152,29 +188,139 @@ func (hm *hostportManagerIPTables) Add(id, name, podIP string, hostportMappings
 	return hm.syncIPTables(append(natChains.Bytes(), natRules.Bytes()...))
 }
 
-func (hm *hostportManagerIPTables) Remove(id string, hostportMappings []*PortMapping) (err error) {
+func (hm *hostportManagerIPTables) AddDirectRouted(id, name, podIP string, hostportMappings []*PortMapping) error {
+	if err := ensureCRIOForwardChain(hm.iptables); err != nil {
+		return err
+	}
+
 	// Ensure atomicity for iptables operations
 	hm.mu.Lock()
 	defer hm.mu.Unlock()
 
-	var existingChains map[utiliptables.Chain]string
+	forwardChains := bytes.NewBuffer(nil)
+	forwardRules := bytes.NewBuffer(nil)
 
-	var existingRules []string
+	writeLine(forwardChains, "*filter")
 
-	existingChains, existingRules, err = getExistingHostportIPTablesRules(hm.iptables)
+	existingChains, existingRules, err := getExistingHostportIPTablesRules(hm.iptables, filterHostportChains)
 	if err != nil {
 		return err
 	}
 
-	// Gather target hostport chains for removal
-	chainsToRemove := []utiliptables.Chain{}
+	newChains := []utiliptables.Chain{}
+
 	for _, pm := range hostportMappings {
-		chainsToRemove = append(chainsToRemove,
-			getHostportChain(kubeHostportChainPrefix, id, pm),
-			getHostportChain(crioMasqueradeChainPrefix, id, pm),
+		protocol := strings.ToLower(string(pm.Protocol))
+		comment := fmt.Sprintf(`"%s hostport %s"`, name, pm.hostPortString("-"))
+		fwdChain := getHostportChain(crioForwardChainPrefix, id, pm)
+		newChains = append(newChains, fwdChain)
+
+		writeLine(forwardChains, utiliptables.MakeChainLine(fwdChain))
+
+		// The host ports equal the container ports, so the pod IP and host
+		// ports match the forwarded traffic.
+		writeLine(forwardRules, "-I", string(crioForwardChain),
+			"-m", "comment", "--comment", comment,
+			"-m", protocol, "-p", protocol, "-d", podIP, "--dport", pm.hostPortString(":"),
+			"-j", string(fwdChain),
 		)
+		writeLine(forwardRules, "-A", string(fwdChain),
+			"-m", "comment", "--comment", comment,
+			"-j", "ACCEPT")
+	}
+
+	filterChains(existingChains, newChains)
+	existingRules = filterRules(existingRules, newChains)
+
+	for _, chain := range existingChains {
+		writeLine(forwardChains, chain)
+	}
+
+	for _, rule := range existingRules {
+		writeLine(forwardRules, rule)
+	}
+
+	writeLine(forwardRules, "COMMIT")
+
+	return hm.syncIPTables(append(forwardChains.Bytes(), forwardRules.Bytes()...))
+}
+
+func (hm *hostportManagerIPTables) Remove(id string, hostportMappings []*PortMapping) (err error) {
+	// Ensure atomicity for iptables operations
+	hm.mu.Lock()
+	defer hm.mu.Unlock()
+
+	for _, tableChains := range []*hostportTableChains{natHostportChains, filterHostportChains} {
+		existingChains, existingRules, err := getExistingHostportIPTablesRules(hm.iptables, tableChains)
+		if err != nil {
+			return err
+		}
+
+		// Gather target hostport chains for removal
+		chainsToRemove := []utiliptables.Chain{}
+
+		for _, pm := range hostportMappings {
+			for _, prefix := range tableChains.prefixes {
+				chainsToRemove = append(chainsToRemove, getHostportChain(prefix, id, pm))
+			}
+		}
+
+		if _, err := hm.removeChains(tableChains.table, existingChains, existingRules, chainsToRemove); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (hm *hostportManagerIPTables) Reconcile(valid map[string][]*PortMapping) (removed int, err error) {
+	// Ensure atomicity for iptables operations
+	hm.mu.Lock()
+	defer hm.mu.Unlock()
+
+	for _, tableChains := range []*hostportTableChains{natHostportChains, filterHostportChains} {
+		existingChains, existingRules, err := getExistingHostportIPTablesRules(hm.iptables, tableChains)
+		if err != nil {
+			return removed, err
+		}
+
+		validChains := map[utiliptables.Chain]bool{}
+
+		for id, hostportMappings := range valid {
+			for _, pm := range hostportMappings {
+				for _, prefix := range tableChains.prefixes {
+					validChains[getHostportChain(prefix, id, pm)] = true
+				}
+			}
+		}
+
+		// Gather the per hostport chains not belonging to any valid mapping
+		chainsToRemove := []utiliptables.Chain{}
+
+		for chain := range existingChains {
+			if !tableChains.isPerHostportChain(chain) {
+				continue
+			}
+
+			if !validChains[chain] {
+				chainsToRemove = append(chainsToRemove, chain)
+			}
+		}
+
+		removedRules, err := hm.removeChains(tableChains.table, existingChains, existingRules, chainsToRemove)
+		if err != nil {
+			return removed, err
+		}
+
+		removed += removedRules
 	}
 
+	return removed, nil
+}
+
+// removeChains removes the provided chains and the rules jumping to them from
+// the table and returns the amount of removed rules.
+func (hm *hostportManagerIPTables) removeChains(table utiliptables.Table, existingChains map[utiliptables.Chain]string, existingRules []string, chainsToRemove []utiliptables.Chain) (int, error) {
 	// remove rules that consists of target chains
 	remainingRules := filterRules(existingRules, chainsToRemove)
 
@@ -189,29 +335,33 @@ func (hm *hostportManagerIPTables) Remove(id string, hostportMappings []*PortMap
 
 	// exit if there is nothing to remove
 	if len(existingChainsToRemove) == 0 {
-		return nil
+		return 0, nil
 	}
 
-	natChains := bytes.NewBuffer(nil)
-	natRules := bytes.NewBuffer(nil)
+	chains := bytes.NewBuffer(nil)
+	rules := bytes.NewBuffer(nil)
 
-	writeLine(natChains, "*nat")
+	writeLine(chains, "*"+string(table))
 
 	for _, chain := range existingChains {
-		writeLine(natChains, chain)
+		writeLine(chains, chain)
 	}
 
 	for _, rule := range remainingRules {
-		writeLine(natRules, rule)
+		writeLine(rules, rule)
 	}
 
 	for _, chain := range existingChainsToRemove {
-		writeLine(natRules, "-X", string(chain))
+		writeLine(rules, "-X", string(chain))
 	}
 
-	writeLine(natRules, "COMMIT")
+	writeLine(rules, "COMMIT")
 
-	return hm.syncIPTables(append(natChains.Bytes(), natRules.Bytes()...))
+	if err := hm.syncIPTables(append(chains.Bytes(), rules.Bytes()...)); err != nil {
+		return 0, err
+	}
+
+	return len(existingRules) - len(remainingRules), nil
 }
 
 // syncIPTables executes iptables-restore with given lines.
@@ -273,49 +423,69 @@ func ensureKubeHostportChains(iptables utiliptables.Interface) error {
 	return nil
 }
 
+// ensureCRIOForwardChain ensures the CRIO-HOSTPORTS-FWD chain is setup correctly.
+func ensureCRIOForwardChain(iptables utiliptables.Interface) error {
+	if _, err := iptables.EnsureChain(utiliptables.TableFilter, crioForwardChain); err != nil {
+		return fmt.Errorf("failed to ensure that %s chain %s exists: %w", utiliptables.TableFilter, crioForwardChain, err)
+	}
+
+	// The chain is prepended to accept the traffic before any rule of the
+	// FORWARD chain rejects it.
+	args := []string{
+		"-m", "comment", "--comment", "crio direct routed hostports",
+		"-j", string(crioForwardChain),
+	}
+	if _, err := iptables.EnsureRule(utiliptables.Prepend, utiliptables.TableFilter, utiliptables.ChainForward, args...); err != nil {
+		return fmt.Errorf("failed to ensure that %s chain %s jumps to %s: %w", utiliptables.TableFilter, utiliptables.ChainForward, crioForwardChain, err)
+	}
+
+	return nil
+}
+
 // getHostportChain takes id, hostport and protocol for a pod and returns associated iptables chain.
 // This is computed by hashing (sha256) then encoding to base32 and truncating, and prepending
 // the prefix. We do this because IPTables Chain Names must be <= 28 chars long, and the longer
 // they are the harder they are to read.
 // WARNING: Please do not change this function. Otherwise, HostportManager may not be able to
-// identify existing iptables chains.
+// identify existing iptables chains. The end of port ranges is only added to
+// keep the chains of single port mappings stable.
 func getHostportChain(prefix, id string, pm *PortMapping) utiliptables.Chain {
-	hash := sha256.Sum256([]byte(id + strconv.Itoa(int(pm.HostPort)) + string(pm.Protocol) + pm.HostIP))
+	hostPort := strconv.Itoa(int(pm.HostPort))
+	if pm.IsRange() {
+		hostPort = pm.hostPortString("-")
+	}
+
+	hash := sha256.Sum256([]byte(id + hostPort + string(pm.Protocol) + pm.HostIP))
 	encoded := base32.StdEncoding.EncodeToString(hash[:])
 
 	return utiliptables.Chain(prefix + encoded[:16])
 }
 
 // getExistingHostportIPTablesRules retrieves raw data from iptables-save, parse it,
-// return all the hostport related chains and rules
+// return all the hostport related chains and rules of the table
 //
 //nolint:gocritic // unnamedResult: consider giving a name to these results
-func getExistingHostportIPTablesRules(iptables utiliptables.Interface) (map[utiliptables.Chain]string, []string, error) {
+func getExistingHostportIPTablesRules(iptables utiliptables.Interface, tableChains *hostportTableChains) (map[utiliptables.Chain]string, []string, error) {
 	iptablesData := bytes.NewBuffer(nil)
 
-	err := iptables.SaveInto(utiliptables.TableNAT, iptablesData)
+	err := iptables.SaveInto(tableChains.table, iptablesData)
 	if err != nil { // if we failed to get any rules
 		return nil, nil, fmt.Errorf("failed to execute iptables-save: %w", err)
 	}
 
-	existingNATChains := getChainLines(utiliptables.TableNAT, iptablesData.Bytes())
+	existingChains := getChainLines(tableChains.table, iptablesData.Bytes())
 
 	existingHostportChains := make(map[utiliptables.Chain]string)
 	existingHostportRules := []string{}
 
-	for chain := range existingNATChains {
-		if chain == kubeHostportsChain || chain == crioMasqueradeChain ||
-			strings.HasPrefix(string(chain), kubeHostportChainPrefix) ||
-			strings.HasPrefix(string(chain), crioMasqueradeChainPrefix) {
-			existingHostportChains[chain] = string(existingNATChains[chain])
+	for chain := range existingChains {
+		if slices.Contains(tableChains.chains, chain) || tableChains.isPerHostportChain(chain) {
+			existingHostportChains[chain] = string(existingChains[chain])
 		}
 	}
 
 	for line := range strings.SplitSeq(iptablesData.String(), "\n") {
-		if strings.HasPrefix(line, "-A "+kubeHostportChainPrefix) ||
-			strings.HasPrefix(line, "-A "+crioMasqueradeChainPrefix) ||
-			strings.HasPrefix(line, fmt.Sprintf("-A %s ", string(kubeHostportsChain))) ||
-			strings.HasPrefix(line, fmt.Sprintf("-A %s ", string(crioMasqueradeChain))) {
+		if tableChains.isHostportRule(line) {
 			existingHostportRules = append(existingHostportRules, line)
 		}
 	}
@@ -323,6 +493,35 @@ func getExistingHostportIPTablesRules(iptables utiliptables.Interface) (map[util
 	return existingHostportChains, existingHostportRules, nil
 }
 
+// isPerHostportChain returns true if the chain is a per hostport chain.
+func (t *hostportTableChains) isPerHostportChain(chain utiliptables.Chain) bool {
+	for _, prefix := range t.prefixes {
+		if strings.HasPrefix(string(chain), prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isHostportRule returns true if the iptables-save line is a rule of any
+// managed chain.
+func (t *hostportTableChains) isHostportRule(line string) bool {
+	for _, prefix := range t.prefixes {
+		if strings.HasPrefix(line, "-A "+prefix) {
+			return true
+		}
+	}
+
+	for _, chain := range t.chains {
+		if strings.HasPrefix(line, fmt.Sprintf("-A %s ", string(chain))) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // getChainLines parses a table's iptables-save data to find chains in the table.
 // It returns a map of iptables.Chain to []byte where the []byte is the chain line
 // from save (with counters etc.).
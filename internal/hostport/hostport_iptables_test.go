@@ -89,6 +89,17 @@ var expectedIPTablesRulesV6 = []string{
 	"-A KUBE-HP-WLTFZLTJ4QV7FRX3 -m comment --comment \"pod3_ns1 hostport 8443\" -m tcp -p tcp -j DNAT --to-destination [2001:beef::4]:443",
 }
 
+var expectedIPTablesRulesPortRanges = []string{
+	"-A KUBE-HOSTPORTS -m comment --comment \"pod7_ns1 hostport 10000-10100\" -m udp -p udp --dport 10000:10100 -j KUBE-HP-MFWKQHRAPZW6ZWZJ",
+	"-A CRIO-HOSTPORTS-MASQ -m comment --comment \"pod7_ns1 hostport 10000-10100\" -j CRIO-MASQ-MFWKQHRAPZW6ZWZJ",
+	"-A KUBE-HOSTPORTS -m comment --comment \"pod7_ns1 hostport 5060-5061\" -m sctp -p sctp --dport 5060:5061 -j KUBE-HP-MSGC7UYPUQLCIQ6X",
+	"-A CRIO-HOSTPORTS-MASQ -m comment --comment \"pod7_ns1 hostport 5060-5061\" -j CRIO-MASQ-MSGC7UYPUQLCIQ6X",
+	"-A KUBE-HP-MFWKQHRAPZW6ZWZJ -m comment --comment \"pod7_ns1 hostport 10000-10100\" -m udp -p udp -j DNAT --to-destination 10.1.1.7/32",
+	"-A CRIO-MASQ-MFWKQHRAPZW6ZWZJ -m comment --comment \"pod7_ns1 hostport 10000-10100\" -m conntrack --ctorigdstport 10000:10100 -m udp -p udp --dport 10000:10100 -s 10.1.1.7/32 -d 10.1.1.7/32 -j MASQUERADE",
+	"-A KUBE-HP-MSGC7UYPUQLCIQ6X -m comment --comment \"pod7_ns1 hostport 5060-5061\" -m sctp -p sctp -d 127.0.0.1/32 -j DNAT --to-destination 10.1.1.7/32",
+	"-A CRIO-MASQ-MSGC7UYPUQLCIQ6X -m comment --comment \"pod7_ns1 hostport 5060-5061\" -m conntrack --ctorigdstport 5060:5061 -m sctp -p sctp --dport 5060:5061 -s 10.1.1.7/32 -d 10.1.1.7/32 -j MASQUERADE",
+}
+
 func checkIPTablesRules(ipt utiliptables.Interface, expectedRules []string) {
 	raw := bytes.NewBuffer(nil)
 	err := ipt.SaveInto(utiliptables.TableNAT, raw)
@@ -204,4 +215,50 @@ var _ = t.Describe("hostPortManagerIPTables", func() {
 		// Check Iptables-save result after deleting hostports
 		checkIPTablesRules(manager.iptables, nil)
 	})
+
+	It("should support port ranges", func() {
+		iptables := newFakeIPTables()
+		iptables.protocol = utiliptables.ProtocolIPv4
+		manager := &hostportManagerIPTables{
+			iptables: iptables,
+		}
+		tc := testCasePortRanges
+
+		Expect(manager.Add(tc.id, tc.name, tc.podIP, tc.portMappings)).To(Succeed())
+		checkIPTablesRules(manager.iptables, expectedIPTablesRulesPortRanges)
+
+		Expect(manager.Remove(tc.id, tc.portMappings)).To(Succeed())
+		checkIPTablesRules(manager.iptables, nil)
+	})
+
+	It("should reconcile orphaned rules", func() {
+		iptables := newFakeIPTables()
+		iptables.protocol = utiliptables.ProtocolIPv4
+		manager := &hostportManagerIPTables{
+			iptables: iptables,
+		}
+
+		for _, tc := range testCasesV4 {
+			Expect(manager.Add(tc.id, tc.name, tc.podIP, tc.portMappings)).To(Succeed())
+		}
+
+		// Only keep the rules of pod3_ns1
+		valid := testCasesV4[1]
+		expectedRules := []string{}
+		for _, rule := range expectedIPTablesRulesV4 {
+			if strings.Contains(rule, valid.name) {
+				expectedRules = append(expectedRules, rule)
+			}
+		}
+
+		removed, err := manager.Reconcile(map[string][]*PortMapping{valid.id: valid.portMappings})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(removed).To(Equal(len(expectedIPTablesRulesV4) - len(expectedRules)))
+		checkIPTablesRules(manager.iptables, expectedRules)
+
+		// Nothing left to reconcile
+		removed, err = manager.Reconcile(map[string][]*PortMapping{valid.id: valid.portMappings})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(removed).To(BeZero())
+	})
 })
@@ -17,6 +17,8 @@ limitations under the License.
 package hostport
 
 import (
+	"strconv"
+
 	v1 "k8s.io/api/core/v1"
 )
 
@@ -33,6 +35,37 @@ type HostPortManager interface {
 	// Remove cleans up matching port mappings
 	// Remove must be able to clean up port mappings without pod IP
 	Remove(id string, hostportMappings []*PortMapping) error
+	// Restore reserves the port mappings of an already running pod, for
+	// example after a restart, without installing them again.
+	Restore(id, name, podIP string, hostportMappings []*PortMapping) error
+	// Reservations returns the host ports currently reserved by pods.
+	Reservations() []Reservation
+	// Reconcile removes the installed port mappings which neither belong to
+	// the provided pods nor to any reserved host port.
+	Reconcile(pods []PodPortMappings) error
+}
+
+// PodPortMappings are the port mappings of a single pod.
+type PodPortMappings struct {
+	// ID is the unique identifier of the pod, e.g. podSandboxID.
+	ID string
+	// PortMappings are the associated port mappings of the pod.
+	PortMappings []*PortMapping
+}
+
+// hostportRules is the interface implemented by the iptables and nftables
+// backends to install and remove the rules of the port mappings.
+type hostportRules interface {
+	// Add installs the rules for the port mappings.
+	Add(id, name, podIP string, hostportMappings []*PortMapping) error
+	// AddDirectRouted installs the rules accepting the forwarded traffic to
+	// the directly routed pod IP for the port mappings, without any NAT.
+	AddDirectRouted(id, name, podIP string, hostportMappings []*PortMapping) error
+	// Remove removes the rules for the port mappings.
+	Remove(id string, hostportMappings []*PortMapping) error
+	// Reconcile removes all rules not belonging to the provided port mappings
+	// per pod ID and returns the amount of removed rules.
+	Reconcile(valid map[string][]*PortMapping) (removed int, err error)
 }
 
 // PortMapping represents a network port in a container.
@@ -41,4 +74,32 @@ type PortMapping struct {
 	ContainerPort int32
 	Protocol      v1.Protocol
 	HostIP        string
+	// HostPortEnd is the last port of a port range mapping, which maps the
+	// host ports HostPort to HostPortEnd to the same ports of the container.
+	// It is zero for single port mappings.
+	HostPortEnd int32 `json:",omitempty"`
+}
+
+// IsRange returns true if the mapping covers a range of ports.
+func (pm *PortMapping) IsRange() bool {
+	return pm.HostPortEnd > pm.HostPort
+}
+
+// lastHostPort returns the last host port covered by the mapping.
+func (pm *PortMapping) lastHostPort() int32 {
+	if pm.IsRange() {
+		return pm.HostPortEnd
+	}
+
+	return pm.HostPort
+}
+
+// hostPortString returns the host port of the mapping, or the first and
+// last port of a range joined by the provided separator.
+func (pm *PortMapping) hostPortString(separator string) string {
+	if pm.IsRange() {
+		return strconv.Itoa(int(pm.HostPort)) + separator + strconv.Itoa(int(pm.HostPortEnd))
+	}
+
+	return strconv.Itoa(int(pm.HostPort))
 }
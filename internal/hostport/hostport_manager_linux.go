@@ -6,24 +6,31 @@ import (
 	"github.com/vishvananda/netlink"
 )
 
-// deleteConntrackEntriesForDstPort delete the conntrack entries for the connections specified
-// by the given destination port, protocol and IP family.
-func deleteConntrackEntriesForDstPort(port uint16, protocol uint8, family netlink.InetFamily) error {
-	filter := &netlink.ConntrackFilter{}
+// deleteConntrackEntriesForDstPorts delete the conntrack entries for the connections specified
+// by the given range of destination ports, protocol and IP family. All ports are
+// matched within a single pass over the conntrack table.
+func deleteConntrackEntriesForDstPorts(first, last uint16, protocol uint8, family netlink.InetFamily) error {
+	filters := make([]netlink.CustomConntrackFilter, 0, int(last-first)+1)
 
-	err := filter.AddProtocol(protocol)
-	if err != nil {
-		return fmt.Errorf("error deleting connection tracking state for protocol: %d Port: %d, error: %w", protocol, port, err)
-	}
+	for port := int(first); port <= int(last); port++ {
+		filter := &netlink.ConntrackFilter{}
 
-	err = filter.AddPort(netlink.ConntrackOrigDstPort, port)
-	if err != nil {
-		return fmt.Errorf("error deleting connection tracking state for protocol: %d Port: %d, error: %w", protocol, port, err)
+		err := filter.AddProtocol(protocol)
+		if err != nil {
+			return fmt.Errorf("error deleting connection tracking state for protocol: %d Port: %d, error: %w", protocol, port, err)
+		}
+
+		err = filter.AddPort(netlink.ConntrackOrigDstPort, uint16(port))
+		if err != nil {
+			return fmt.Errorf("error deleting connection tracking state for protocol: %d Port: %d, error: %w", protocol, port, err)
+		}
+
+		filters = append(filters, filter)
 	}
 
-	_, err = netlink.ConntrackDeleteFilters(netlink.ConntrackTable, family, filter)
+	_, err := netlink.ConntrackDeleteFilters(netlink.ConntrackTable, family, filters...)
 	if err != nil {
-		return fmt.Errorf("error deleting connection tracking state for protocol: %d Port: %d, error: %w", protocol, port, err)
+		return fmt.Errorf("error deleting connection tracking state for protocol: %d Ports: %d-%d, error: %w", protocol, first, last, err)
 	}
 
 	return nil
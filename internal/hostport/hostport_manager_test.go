@@ -152,3 +152,25 @@ var testCasesV6 = []testCase{
 		},
 	},
 }
+
+var testCasePortRanges = testCase{
+	// map port ranges to the same ports of the pod
+	id:    "6b4f2e7f1c0a0b2f8b5a9e3d4c6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d3e4f",
+	name:  "pod7_ns1",
+	podIP: "10.1.1.7",
+	portMappings: []*PortMapping{
+		{
+			HostPort:      10000,
+			HostPortEnd:   10100,
+			ContainerPort: 10000,
+			Protocol:      v1.ProtocolUDP,
+		},
+		{
+			HostPort:      5060,
+			HostPortEnd:   5061,
+			ContainerPort: 5060,
+			Protocol:      v1.ProtocolSCTP,
+			HostIP:        "127.0.0.1",
+		},
+	},
+}
@@ -9,8 +9,8 @@ import (
 	"github.com/vishvananda/netlink"
 )
 
-// deleteConntrackEntriesForDstPort delete the conntrack entries for the connections specified
-// by the given destination port, protocol and IP family
-func deleteConntrackEntriesForDstPort(port uint16, protocol uint8, family netlink.InetFamily) error {
-	return fmt.Errorf("deleteConntrackEntriesForDstPort unsupported on %s", runtime.GOOS)
+// deleteConntrackEntriesForDstPorts delete the conntrack entries for the connections specified
+// by the given range of destination ports, protocol and IP family
+func deleteConntrackEntriesForDstPorts(first, last uint16, protocol uint8, family netlink.InetFamily) error {
+	return fmt.Errorf("deleteConntrackEntriesForDstPorts unsupported on %s", runtime.GOOS)
 }
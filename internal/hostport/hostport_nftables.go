@@ -33,11 +33,24 @@ const (
 	hostPortsTable string = "crio-hostports"
 
 	// maps and sets referred to from HostportManager.
-	hostPortsMap   string = "hostports"
-	hostIPPortsMap string = "hostipports"
-	hairpinSet     string = "hairpins"
+	hostPortsMap        string = "hostports"
+	hostIPPortsMap      string = "hostipports"
+	hostPortRangesMap   string = "hostportranges"
+	hostIPPortRangesMap string = "hostipportranges"
+	hairpinSet          string = "hairpins"
+	directRoutesSet     string = "directroutes"
 )
 
+// hostPortsObjects are the maps and sets containing per pod elements.
+var hostPortsObjects = []struct{ objectType, name string }{
+	{"map", hostPortsMap},
+	{"map", hostIPPortsMap},
+	{"map", hostPortRangesMap},
+	{"map", hostIPPortRangesMap},
+	{"set", hairpinSet},
+	{"set", directRoutesSet},
+}
+
 type hostportManagerNFTables struct {
 	nft    knftables.Interface
 	family knftables.Family
@@ -72,6 +85,31 @@ func (hm *hostportManagerNFTables) Add(id, name, podIP string, hostportMappings
 
 	for _, pm := range hostportMappings {
 		protocol := strings.ToLower(string(pm.Protocol))
+
+		// Port ranges are looked up in interval maps, which keep the
+		// destination port when translating the address.
+		if pm.IsRange() {
+			hostPorts := pm.hostPortString("-")
+
+			if isUnspecifiedIP(pm.HostIP) {
+				tx.Add(&knftables.Element{
+					Map:     hostPortRangesMap,
+					Key:     []string{protocol, hostPorts},
+					Value:   []string{podIP},
+					Comment: &comment,
+				})
+			} else {
+				tx.Add(&knftables.Element{
+					Map:     hostIPPortRangesMap,
+					Key:     []string{pm.HostIP, protocol, hostPorts},
+					Value:   []string{podIP},
+					Comment: &comment,
+				})
+			}
+
+			continue
+		}
+
 		hostPort := strconv.Itoa(int(pm.HostPort))
 		containerPort := strconv.Itoa(int(pm.ContainerPort))
 
@@ -117,47 +155,60 @@ func (hm *hostportManagerNFTables) Add(id, name, podIP string, hostportMappings
 	return nil
 }
 
-func (hm *hostportManagerNFTables) Remove(id string, hostportMappings []*PortMapping) (err error) {
+func (hm *hostportManagerNFTables) AddDirectRouted(id, name, podIP string, hostportMappings []*PortMapping) error {
 	// Ensure atomicity for nftables operations
 	hm.mu.Lock()
 	defer hm.mu.Unlock()
 
+	tx := hm.nft.NewTransaction()
+	ensureHostPortsTable(tx, hm.family)
+
+	// The host ports equal the container ports, so the pod IP and host ports
+	// match the forwarded traffic.
 	comment := hashSandboxID(id)
 
-	// Fetch the existing map/set elements.
-	existingHostPorts, err := hm.nft.ListElements(context.TODO(), "map", hostPortsMap)
-	if err != nil && !knftables.IsNotFound(err) {
-		return fmt.Errorf("could not list existing hostports: %w", err)
+	for _, pm := range hostportMappings {
+		tx.Add(&knftables.Element{
+			Set: directRoutesSet,
+			Key: []string{
+				podIP, strings.ToLower(string(pm.Protocol)), pm.hostPortString("-"),
+			},
+			Comment: &comment,
+		})
 	}
 
-	existingHostIPPorts, err := hm.nft.ListElements(context.TODO(), "map", hostIPPortsMap)
-	if err != nil && !knftables.IsNotFound(err) {
-		return fmt.Errorf("could not list existing hostports: %w", err)
+	if err := hm.nft.Run(context.TODO(), tx); err != nil {
+		return fmt.Errorf("failed to add nftables direct routing rules: %w", err)
 	}
 
-	existingHairpins, err := hm.nft.ListElements(context.TODO(), "set", hairpinSet)
-	if err != nil && !knftables.IsNotFound(err) {
-		return fmt.Errorf("could not list existing hostports: %w", err)
-	}
+	return nil
+}
+
+func (hm *hostportManagerNFTables) Remove(id string, hostportMappings []*PortMapping) (err error) {
+	// Ensure atomicity for nftables operations
+	hm.mu.Lock()
+	defer hm.mu.Unlock()
+
+	comment := hashSandboxID(id)
 
-	// Delete each one that refers to this pod in its Comment.
+	// Delete each existing map/set element that refers to this pod in its
+	// Comment.
 	tx := hm.nft.NewTransaction()
 
-	for _, elem := range existingHostPorts {
-		if elem.Comment != nil && *elem.Comment == comment {
-			tx.Delete(elem)
-		}
-	}
+	for _, object := range hostPortsObjects {
+		elements, err := hm.nft.ListElements(context.TODO(), object.objectType, object.name)
+		if err != nil {
+			if knftables.IsNotFound(err) {
+				continue
+			}
 
-	for _, elem := range existingHostIPPorts {
-		if elem.Comment != nil && *elem.Comment == comment {
-			tx.Delete(elem)
+			return fmt.Errorf("could not list existing hostports: %w", err)
 		}
-	}
 
-	for _, elem := range existingHairpins {
-		if elem.Comment != nil && *elem.Comment == comment {
-			tx.Delete(elem)
+		for _, elem := range elements {
+			if elem.Comment != nil && *elem.Comment == comment {
+				tx.Delete(elem)
+			}
 		}
 	}
 
@@ -173,6 +224,47 @@ func (hm *hostportManagerNFTables) Remove(id string, hostportMappings []*PortMap
 	return nil
 }
 
+func (hm *hostportManagerNFTables) Reconcile(valid map[string][]*PortMapping) (removed int, err error) {
+	// Ensure atomicity for nftables operations
+	hm.mu.Lock()
+	defer hm.mu.Unlock()
+
+	validComments := map[string]bool{}
+	for id := range valid {
+		validComments[hashSandboxID(id)] = true
+	}
+
+	tx := hm.nft.NewTransaction()
+
+	for _, object := range hostPortsObjects {
+		elements, err := hm.nft.ListElements(context.TODO(), object.objectType, object.name)
+		if err != nil {
+			if knftables.IsNotFound(err) {
+				continue
+			}
+
+			return 0, fmt.Errorf("could not list existing hostports: %w", err)
+		}
+
+		// Delete each one that does not refer to a valid pod in its Comment.
+		for _, elem := range elements {
+			if elem.Comment != nil && !validComments[*elem.Comment] {
+				tx.Delete(elem)
+			}
+		}
+	}
+
+	if tx.NumOperations() == 0 {
+		return 0, nil
+	}
+
+	if err := hm.nft.Run(context.TODO(), tx); err != nil {
+		return 0, fmt.Errorf("failed to clean up nftables hostport maps: %w", err)
+	}
+
+	return tx.NumOperations(), nil
+}
+
 // hashSandboxID hashes the sandbox ID to get a suitable identifier for an nftables
 // comment (which must be at most 128 characters).
 func hashSandboxID(id string) string {
@@ -215,6 +307,22 @@ func ensureHostPortsTable(tx *knftables.Transaction, family knftables.Family) {
 		),
 		Comment: knftables.PtrTo("hostports on specific IPs (hostIP . protocol . hostPort -> podIP . podPort)"),
 	})
+	tx.Add(&knftables.Map{
+		Name: hostPortRangesMap,
+		Type: knftables.Concat(
+			"inet_proto", ".", "inet_service", ":", ipaddr,
+		),
+		Flags:   []knftables.SetFlag{knftables.IntervalFlag},
+		Comment: knftables.PtrTo("hostport ranges on all local IPs (protocol . hostPorts -> podIP)"),
+	})
+	tx.Add(&knftables.Map{
+		Name: hostIPPortRangesMap,
+		Type: knftables.Concat(
+			ipaddr, ".", "inet_proto", ".", "inet_service", ":", ipaddr,
+		),
+		Flags:   []knftables.SetFlag{knftables.IntervalFlag},
+		Comment: knftables.PtrTo("hostport ranges on specific IPs (hostIP . protocol . hostPorts -> podIP)"),
+	})
 
 	// Create the "hostports" chain with the map lookup rules, and then create
 	// "prerouting" and "output" chains that call the "hostports" chain for
@@ -225,7 +333,9 @@ func ensureHostPortsTable(tx *knftables.Transaction, family knftables.Family) {
 	tx.Flush(&knftables.Chain{
 		Name: "hostports",
 	})
-	// hostIPPortsMap check must come first since the hostPortsMap rule catches all IPs
+	// hostIPPortsMap check must come first since the hostPortsMap rule catches all IPs.
+	// The port range maps only translate the address, so a single lookup
+	// covers all ports of a range.
 	tx.Add(&knftables.Rule{
 		Chain: "hostports",
 		Rule: knftables.Concat(
@@ -233,6 +343,13 @@ func ensureHostPortsTable(tx *knftables.Transaction, family knftables.Family) {
 			ip, "daddr", ".", "meta l4proto", ".", "th dport", "map", "@", hostIPPortsMap,
 		),
 	})
+	tx.Add(&knftables.Rule{
+		Chain: "hostports",
+		Rule: knftables.Concat(
+			"dnat", ip, "to",
+			ip, "daddr", ".", "meta l4proto", ".", "th dport", "map", "@", hostIPPortRangesMap,
+		),
+	})
 	tx.Add(&knftables.Rule{
 		Chain: "hostports",
 		Rule: knftables.Concat(
@@ -240,6 +357,13 @@ func ensureHostPortsTable(tx *knftables.Transaction, family knftables.Family) {
 			"meta l4proto", ".", "th dport", "map", "@", hostPortsMap,
 		),
 	})
+	tx.Add(&knftables.Rule{
+		Chain: "hostports",
+		Rule: knftables.Concat(
+			"dnat", ip, "to",
+			"meta l4proto", ".", "th dport", "map", "@", hostPortRangesMap,
+		),
+	})
 
 	tx.Add(&knftables.Chain{
 		Name:     "prerouting",
@@ -303,4 +427,31 @@ func ensureHostPortsTable(tx *knftables.Transaction, family knftables.Family) {
 			"masquerade",
 		),
 	})
+
+	// Create the "forward" chain accepting the traffic to directly routed
+	// hostports, which are not translated by the "hostports" chain.
+	tx.Add(&knftables.Set{
+		Name: directRoutesSet,
+		Type: knftables.Concat(
+			ipaddr, ".", "inet_proto", ".", "inet_service",
+		),
+		Flags:   []knftables.SetFlag{knftables.IntervalFlag},
+		Comment: knftables.PtrTo("directly routed hostports (podIP . protocol . hostPorts)"),
+	})
+	tx.Add(&knftables.Chain{
+		Name:     "forward",
+		Type:     knftables.PtrTo(knftables.FilterType),
+		Hook:     knftables.PtrTo(knftables.ForwardHook),
+		Priority: knftables.PtrTo(knftables.FilterPriority),
+	})
+	tx.Flush(&knftables.Chain{
+		Name: "forward",
+	})
+	tx.Add(&knftables.Rule{
+		Chain: "forward",
+		Rule: knftables.Concat(
+			ip, "daddr", ".", "meta l4proto", ".", "th dport", "@", directRoutesSet,
+			"accept",
+		),
+	})
 }
@@ -56,6 +56,12 @@ var expectedNFTablesElementsV6 = []string{
 	`add element ip6 crio-hostports hairpins { 2001:beef::4 . 2001:beef::4 comment "XDYUBNL7AIWQOXRB" }`,
 }
 
+var expectedNFTablesElementsPortRanges = []string{
+	`add element ip crio-hostports hostportranges { udp . 10000-10100 comment "ZG7OV4YW2DC7BSUY" : 10.1.1.7 }`,
+	`add element ip crio-hostports hostipportranges { 127.0.0.1 . sctp . 5060-5061 comment "ZG7OV4YW2DC7BSUY" : 10.1.1.7 }`,
+	`add element ip crio-hostports hairpins { 10.1.1.7 . 10.1.1.7 comment "ZG7OV4YW2DC7BSUY" }`,
+}
+
 func checkNFTablesElements(nft *knftables.Fake, expectedElements []string) {
 	dump := nft.Dump()
 
@@ -85,15 +91,22 @@ var _ = t.Describe("hostPortManagerNFTables", func() {
 		dump := fakeNFT.Dump()
 		expected := `
 add table ip crio-hostports { comment "HostPort rules created by CRI-O" ; }
+add chain ip crio-hostports forward { type filter hook forward priority 0 ; }
 add chain ip crio-hostports hostports
 add chain ip crio-hostports masquerading { type nat hook postrouting priority 100 ; }
 add chain ip crio-hostports output { type nat hook output priority -100 ; }
 add chain ip crio-hostports prerouting { type nat hook prerouting priority -100 ; }
+add set ip crio-hostports directroutes { type ipv4_addr . inet_proto . inet_service ; flags interval ; comment "directly routed hostports (podIP . protocol . hostPorts)" ; }
 add set ip crio-hostports hairpins { type ipv4_addr . ipv4_addr ; comment "hostport hairpin connections" ; }
+add map ip crio-hostports hostipportranges { type ipv4_addr . inet_proto . inet_service : ipv4_addr ; flags interval ; comment "hostport ranges on specific IPs (hostIP . protocol . hostPorts -> podIP)" ; }
 add map ip crio-hostports hostipports { type ipv4_addr . inet_proto . inet_service : ipv4_addr . inet_service ; comment "hostports on specific IPs (hostIP . protocol . hostPort -> podIP . podPort)" ; }
+add map ip crio-hostports hostportranges { type inet_proto . inet_service : ipv4_addr ; flags interval ; comment "hostport ranges on all local IPs (protocol . hostPorts -> podIP)" ; }
 add map ip crio-hostports hostports { type inet_proto . inet_service : ipv4_addr . inet_service ; comment "hostports on all local IPs (protocol . hostPort -> podIP . podPort)" ; }
+add rule ip crio-hostports forward ip daddr . meta l4proto . th dport @directroutes accept
 add rule ip crio-hostports hostports dnat ip addr . port to ip daddr . meta l4proto . th dport map @hostipports
+add rule ip crio-hostports hostports dnat ip to ip daddr . meta l4proto . th dport map @hostipportranges
 add rule ip crio-hostports hostports dnat ip addr . port to meta l4proto . th dport map @hostports
+add rule ip crio-hostports hostports dnat ip to meta l4proto . th dport map @hostportranges
 add rule ip crio-hostports masquerading ct status & dnat|snat == dnat ip saddr . ip daddr @hairpins masquerade
 add rule ip crio-hostports output fib daddr type local  goto hostports
 add rule ip crio-hostports prerouting fib daddr type local  goto hostports
@@ -152,4 +165,50 @@ add rule ip crio-hostports prerouting fib daddr type local  goto hostports
 		// Check nftables after deleting hostports
 		checkNFTablesElements(fakeNFT, nil)
 	})
+
+	It("should support port ranges", func() {
+		fakeNFT := knftables.NewFake(knftables.IPv4Family, hostPortsTable)
+		manager := &hostportManagerNFTables{
+			nft:    fakeNFT,
+			family: knftables.IPv4Family,
+		}
+		tc := testCasePortRanges
+
+		Expect(manager.Add(tc.id, tc.name, tc.podIP, tc.portMappings)).To(Succeed())
+		checkNFTablesElements(fakeNFT, expectedNFTablesElementsPortRanges)
+
+		Expect(manager.Remove(tc.id, tc.portMappings)).To(Succeed())
+		checkNFTablesElements(fakeNFT, nil)
+	})
+
+	It("should reconcile orphaned elements", func() {
+		fakeNFT := knftables.NewFake(knftables.IPv4Family, hostPortsTable)
+		manager := &hostportManagerNFTables{
+			nft:    fakeNFT,
+			family: knftables.IPv4Family,
+		}
+
+		// Nothing to reconcile without a table
+		removed, err := manager.Reconcile(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(removed).To(BeZero())
+
+		for _, tc := range testCasesV4 {
+			Expect(manager.Add(tc.id, tc.name, tc.podIP, tc.portMappings)).To(Succeed())
+		}
+
+		// Only keep the elements of pod3_ns1
+		valid := testCasesV4[1]
+		expectedElements := []string{}
+		for _, elem := range expectedNFTablesElementsV4 {
+			if strings.Contains(elem, hashSandboxID(valid.id)) {
+				expectedElements = append(expectedElements, elem)
+			}
+		}
+
+		removed, err = manager.Reconcile(map[string][]*PortMapping{valid.id: valid.portMappings})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(removed).To(Equal(len(expectedNFTablesElementsV4) - len(expectedElements)))
+		checkNFTablesElements(fakeNFT, expectedElements)
+	})
 })
@@ -14,20 +14,30 @@ import (
 	"sigs.k8s.io/knftables"
 
 	utiliptables "github.com/cri-o/cri-o/internal/iptables"
+	"github.com/cri-o/cri-o/server/metrics"
 )
 
 // metaHostportManager is a HostPortManager that manages other HostPort managers internally.
 type metaHostportManager struct {
-	managers map[utilnet.IPFamily]*hostportManagers
+	managers     map[utilnet.IPFamily]*hostportManagers
+	reservations *reservations
+
+	// ipv6DirectRouting installs only the rules accepting the forwarded
+	// traffic for IPv6 host ports instead of any NAT66 rules, because the pod
+	// IPv6 addresses are routed directly.
+	ipv6DirectRouting bool
 }
 
 type hostportManagers struct {
-	iptables HostPortManager
-	nftables HostPortManager
+	iptables hostportRules
+	nftables hostportRules
 }
 
-// NewMetaHostportManager creates a new HostPortManager.
-func NewMetaHostportManager(ctx context.Context) (HostPortManager, error) {
+// NewMetaHostportManager creates a new HostPortManager. If ipv6DirectRouting
+// is set, no NAT66 rules get installed for IPv6 pods, which then have to be
+// reachable via their routed address on the container port instead. Only the
+// forwarded traffic to them gets accepted.
+func NewMetaHostportManager(ctx context.Context, ipv6DirectRouting bool) (HostPortManager, error) {
 	iptv4, iptErr := newHostportManagerIPTables(ctx, utiliptables.ProtocolIPv4)
 	nftv4, nftErr := newHostportManagerNFTables(knftables.IPv4Family)
 
@@ -40,20 +50,26 @@ func NewMetaHostportManager(ctx context.Context) (HostPortManager, error) {
 	nftv6, nftErr := newHostportManagerNFTables(knftables.IPv6Family)
 
 	switch {
+	case ipv6DirectRouting:
+		logrus.Infof("Using direct routing for IPv6 hostports")
 	case nftv6 == nil:
 		logrus.Infof("No kernel support for IPv6: %v", nftErr)
 	case iptv6 == nil:
 		logrus.Infof("No iptables support for IPv6: %v", iptErr)
 	}
 
-	return newMetaHostportManagerInternal(iptv4, iptv6, nftv4, nftv6), nil
+	mh := newMetaHostportManagerInternal(iptv4, iptv6, nftv4, nftv6)
+	mh.ipv6DirectRouting = ipv6DirectRouting
+
+	return mh, nil
 }
 
 // internal metaHostportManager constructor; requires that at least one of the
 // sub-managers is non-nil.
-func newMetaHostportManagerInternal(iptv4, iptv6 *hostportManagerIPTables, nftv4, nftv6 *hostportManagerNFTables) HostPortManager {
+func newMetaHostportManagerInternal(iptv4, iptv6 *hostportManagerIPTables, nftv4, nftv6 *hostportManagerNFTables) *metaHostportManager {
 	mh := &metaHostportManager{
-		managers: make(map[utilnet.IPFamily]*hostportManagers),
+		managers:     make(map[utilnet.IPFamily]*hostportManagers),
+		reservations: newReservations(),
 	}
 
 	if iptv4 != nil || nftv4 != nil {
@@ -98,6 +114,10 @@ func (mh *metaHostportManager) Add(id, name, podIP string, hostportMappings []*P
 		return nil
 	}
 
+	if family == utilnet.IPv6 && mh.ipv6DirectRouting {
+		return mh.addDirectRouted(id, name, podIP, hostportMappings)
+	}
+
 	managers := mh.managers[family]
 	if managers == nil {
 		// No support for IPv6 but we got an IPv6 pod. This shouldn't happen.
@@ -111,8 +131,16 @@ func (mh *metaHostportManager) Add(id, name, podIP string, hostportMappings []*P
 		hm = managers.iptables
 	}
 
+	// Reject mappings already claimed by other pods, because their rules would
+	// silently shadow each other.
+	if err := mh.reservations.reserve(id, name, family, hostportMappings); err != nil {
+		return err
+	}
+
 	err := hm.Add(id, name, podIP, hostportMappings)
 	if err != nil {
+		mh.reservations.release(id, family, hostportMappings)
+
 		return err
 	}
 
@@ -121,26 +149,66 @@ func (mh *metaHostportManager) Add(id, name, podIP string, hostportMappings []*P
 	// that the packets received by the node after iptables rule removal will create a
 	// new conntrack entry without any DNAT. That will result in blackhole of the
 	// traffic even after correct iptables rules have been added back.
-	conntrackPortsToRemove := []int{}
+	conntrackMappingsToRemove := []*PortMapping{}
+	conntrackPortsToRemove := []string{}
 
 	for _, pm := range hostportMappings {
 		if pm.Protocol == v1.ProtocolUDP {
-			conntrackPortsToRemove = append(conntrackPortsToRemove, int(pm.HostPort))
+			conntrackMappingsToRemove = append(conntrackMappingsToRemove, pm)
+			conntrackPortsToRemove = append(conntrackPortsToRemove, pm.hostPortString("-"))
 		}
 	}
 
 	logrus.Infof("Deleting UDP conntrack entries for IPv%s: %v", family, conntrackPortsToRemove)
 
-	for _, port := range conntrackPortsToRemove {
-		err = deleteConntrackEntriesForDstPort(uint16(port), unix.IPPROTO_UDP, netlinkFamily[family])
+	for _, pm := range conntrackMappingsToRemove {
+		err = deleteConntrackEntriesForDstPorts(uint16(pm.HostPort), uint16(pm.lastHostPort()), unix.IPPROTO_UDP, netlinkFamily[family])
 		if err != nil {
-			logrus.Errorf("Failed to clear udp conntrack for port %d, error: %v", port, err)
+			logrus.Errorf("Failed to clear udp conntrack for port %s, error: %v", pm.hostPortString("-"), err)
 		}
 	}
 
 	return nil
 }
 
+// addDirectRouted reserves the host ports of an IPv6 pod and installs the rules
+// accepting the forwarded traffic to them, without any NAT66 rules. Clients
+// reach the pod directly via its routed address, which only works if the host
+// and container ports are the same.
+func (mh *metaHostportManager) addDirectRouted(id, name, podIP string, hostportMappings []*PortMapping) error {
+	for _, pm := range hostportMappings {
+		if pm.HostPort != pm.ContainerPort {
+			return fmt.Errorf("host port %s/%s differs from container port %d, which is unsupported with IPv6 direct routing",
+				pm.hostPortString("-"), pm.Protocol, pm.ContainerPort)
+		}
+	}
+
+	if err := mh.reservations.reserve(id, name, utilnet.IPv6, hostportMappings); err != nil {
+		return err
+	}
+
+	managers := mh.managers[utilnet.IPv6]
+	if managers == nil {
+		// No IPv6 firewall support, which could reject the forwarded traffic.
+		logrus.Debugf("Not accepting the forwarded IPv6 hostport traffic of pod %s: no IPv6 firewall support", name)
+
+		return nil
+	}
+
+	hm := managers.nftables
+	if hm == nil {
+		hm = managers.iptables
+	}
+
+	if err := hm.AddDirectRouted(id, name, podIP, hostportMappings); err != nil {
+		mh.reservations.release(id, utilnet.IPv6, hostportMappings)
+
+		return err
+	}
+
+	return nil
+}
+
 func (mh *metaHostportManager) Remove(id string, hostportMappings []*PortMapping) error {
 	var errstrings []string
 	// Remove may not have the IP information, so we try to clean us much as possible
@@ -169,6 +237,72 @@ func (mh *metaHostportManager) Remove(id string, hostportMappings []*PortMapping
 		}
 	}
 
+	mh.reservations.release(id, "", hostportMappings)
+
+	if len(errstrings) > 0 {
+		return errors.New(strings.Join(errstrings, "\n"))
+	}
+
+	return nil
+}
+
+func (mh *metaHostportManager) Restore(id, name, podIP string, hostportMappings []*PortMapping) error {
+	family := utilnet.IPFamilyOfString(podIP)
+
+	hostportMappings = filterHostportMappings(hostportMappings, family)
+	if len(hostportMappings) == 0 {
+		return nil
+	}
+
+	return mh.reservations.reserve(id, name, family, hostportMappings)
+}
+
+func (mh *metaHostportManager) Reservations() []Reservation {
+	return mh.reservations.list()
+}
+
+func (mh *metaHostportManager) Reconcile(pods []PodPortMappings) error {
+	// Reserved host ports are always valid to not remove the rules of pods
+	// being added concurrently.
+	valid := map[string][]*PortMapping{}
+	for _, reservation := range mh.reservations.list() {
+		valid[reservation.PodID] = append(valid[reservation.PodID], &PortMapping{
+			HostPort:    reservation.HostPort,
+			HostPortEnd: reservation.HostPortEnd,
+			Protocol:    reservation.Protocol,
+			HostIP:      reservation.HostIP,
+		})
+	}
+
+	for _, pod := range pods {
+		valid[pod.ID] = append(valid[pod.ID], pod.PortMappings...)
+	}
+
+	var errstrings []string
+
+	for family, managers := range mh.managers {
+		for backend, rules := range map[string]hostportRules{
+			"iptables": managers.iptables,
+			"nftables": managers.nftables,
+		} {
+			if rules == nil {
+				continue
+			}
+
+			removed, err := rules.Reconcile(valid)
+			if err != nil {
+				errstrings = append(errstrings, err.Error())
+
+				continue
+			}
+
+			if removed > 0 {
+				logrus.Infof("Removed %d orphaned %s hostport rules for IPv%s", removed, backend, family)
+				metrics.Instance().MetricHostportRulesRemovedAdd(backend, removed)
+			}
+		}
+	}
+
 	if len(errstrings) > 0 {
 		return errors.New(strings.Join(errstrings, "\n"))
 	}
@@ -1,6 +1,9 @@
 package hostport
 
 import (
+	"bytes"
+	"strings"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	v1 "k8s.io/api/core/v1"
@@ -269,4 +272,271 @@ var _ = t.Describe("MetaHostportManager", func() {
 		checkIPTablesRules(iptables, nil)
 		checkNFTablesElements(nft4, nil)
 	})
+
+	It("should reject conflicting port mappings of different pods", func() {
+		nft4 := knftables.NewFake(knftables.IPv4Family, hostPortsTable)
+
+		manager := newMetaHostportManagerInternal(
+			nil,
+			nil,
+			&hostportManagerNFTables{nft: nft4, family: knftables.IPv4Family},
+			nil,
+		)
+
+		tc := testCasesV4[0]
+		Expect(manager.Add(tc.id, tc.name, tc.podIP, tc.portMappings)).To(Succeed())
+
+		// Same host port and protocol as the first mapping of tc
+		conflicting := []*PortMapping{{
+			HostPort:      8080,
+			ContainerPort: 8080,
+			Protocol:      v1.ProtocolTCP,
+		}}
+
+		err := manager.Add("other", "other_ns", "10.1.1.10", conflicting)
+		Expect(err).To(MatchError(ErrHostPortConflict))
+		Expect(manager.Reservations()).To(HaveLen(len(tc.portMappings)))
+
+		// The port can be reserved again after removing the pod
+		Expect(manager.Remove(tc.id, tc.portMappings)).To(Succeed())
+		Expect(manager.Reservations()).To(BeEmpty())
+		Expect(manager.Add("other", "other_ns", "10.1.1.10", conflicting)).To(Succeed())
+		Expect(manager.Remove("other", conflicting)).To(Succeed())
+		checkNFTablesElements(nft4, nil)
+	})
+
+	It("should restore reservations without adding rules", func() {
+		nft4 := knftables.NewFake(knftables.IPv4Family, hostPortsTable)
+
+		manager := newMetaHostportManagerInternal(
+			nil,
+			nil,
+			&hostportManagerNFTables{nft: nft4, family: knftables.IPv4Family},
+			nil,
+		)
+
+		tc := testCasesV4[1]
+		Expect(manager.Restore(tc.id, tc.name, tc.podIP, tc.portMappings)).To(Succeed())
+
+		Expect(manager.Reservations()).To(Equal([]Reservation{{
+			PodID:    tc.id,
+			PodName:  tc.name,
+			Family:   utilnet.IPv4,
+			HostPort: 8443,
+			Protocol: v1.ProtocolTCP,
+		}}))
+		checkNFTablesElements(nft4, nil)
+
+		err := manager.Add("other", "other_ns", "10.1.1.10", tc.portMappings)
+		Expect(err).To(MatchError(ErrHostPortConflict))
+	})
+
+	It("should reconcile rules of unknown and unreserved pods", func() {
+		nft4 := knftables.NewFake(knftables.IPv4Family, hostPortsTable)
+
+		manager := newMetaHostportManagerInternal(
+			nil,
+			nil,
+			&hostportManagerNFTables{nft: nft4, family: knftables.IPv4Family},
+			nil,
+		)
+
+		for _, tc := range testCasesV4 {
+			Expect(manager.Add(tc.id, tc.name, tc.podIP, tc.portMappings)).To(Succeed())
+		}
+
+		// Known pods and reservations are both kept
+		Expect(manager.Reconcile([]PodPortMappings{{
+			ID:           testCasesV4[0].id,
+			PortMappings: testCasesV4[0].portMappings,
+		}})).To(Succeed())
+		checkNFTablesElements(nft4, expectedNFTablesElementsV4)
+
+		// Rules of pods without a reservation are orphaned, for example
+		// after a crash
+		manager = newMetaHostportManagerInternal(
+			nil,
+			nil,
+			&hostportManagerNFTables{nft: nft4, family: knftables.IPv4Family},
+			nil,
+		)
+		Expect(manager.Reconcile([]PodPortMappings{{
+			ID:           testCasesV4[0].id,
+			PortMappings: testCasesV4[0].portMappings,
+		}})).To(Succeed())
+		checkNFTablesElements(nft4, expectedNFTablesElementsV4[:5])
+	})
+
+	It("should add dual-stack pods with mixed host IPs per family", func() {
+		nft4 := knftables.NewFake(knftables.IPv4Family, hostPortsTable)
+		nft6 := knftables.NewFake(knftables.IPv6Family, hostPortsTable)
+
+		manager := newMetaHostportManagerInternal(
+			nil,
+			nil,
+			&hostportManagerNFTables{nft: nft4, family: knftables.IPv4Family},
+			&hostportManagerNFTables{nft: nft6, family: knftables.IPv6Family},
+		)
+
+		mappings := []*PortMapping{
+			{HostPort: 8080, ContainerPort: 80, Protocol: v1.ProtocolTCP, HostIP: "192.168.2.7"},
+			{HostPort: 8080, ContainerPort: 80, Protocol: v1.ProtocolTCP, HostIP: "2001:beef::2"},
+			{HostPort: 8443, ContainerPort: 443, Protocol: v1.ProtocolTCP},
+		}
+		podIPs := []string{"10.1.1.2", "2001:beef::3"}
+
+		Expect(ValidateHostIPFamilies(podIPs, mappings)).To(Succeed())
+
+		for _, ip := range podIPs {
+			Expect(manager.Add("dual", "dual_ns", ip, mappings)).To(Succeed())
+		}
+
+		// Each family reserves its own host IP and the unspecified one
+		reservations := manager.Reservations()
+		Expect(reservations).To(HaveLen(4))
+
+		for _, r := range reservations {
+			if r.HostIP != "" {
+				Expect(utilnet.IPFamilyOfString(r.HostIP)).To(Equal(r.Family))
+			}
+		}
+
+		Expect(manager.Remove("dual", mappings)).To(Succeed())
+		Expect(manager.Reservations()).To(BeEmpty())
+		checkNFTablesElements(nft4, nil)
+		checkNFTablesElements(nft6, nil)
+	})
+
+	It("should reserve IPv6 host ports on direct routing without IPv6 firewall support", func() {
+		nft4 := knftables.NewFake(knftables.IPv4Family, hostPortsTable)
+
+		manager := newMetaHostportManagerInternal(
+			nil,
+			nil,
+			&hostportManagerNFTables{nft: nft4, family: knftables.IPv4Family},
+			nil,
+		)
+		manager.ipv6DirectRouting = true
+
+		mappings := []*PortMapping{
+			{HostPort: 8080, ContainerPort: 8080, Protocol: v1.ProtocolTCP},
+		}
+
+		// No IPv6 managers are required
+		Expect(manager.Add("v6", "v6_ns", "2001:beef::2", mappings)).To(Succeed())
+		Expect(manager.Reservations()).To(Equal([]Reservation{{
+			PodID:    "v6",
+			PodName:  "v6_ns",
+			Family:   utilnet.IPv6,
+			HostPort: 8080,
+			Protocol: v1.ProtocolTCP,
+		}}))
+
+		err := manager.Add("other", "other_ns", "2001:beef::3", mappings)
+		Expect(err).To(MatchError(ErrHostPortConflict))
+
+		// IPv4 pods still get their rules installed
+		Expect(manager.Add("v4", "v4_ns", "10.1.1.2", mappings)).To(Succeed())
+		checkNFTablesElements(nft4, []string{
+			`add element ip crio-hostports hairpins { 10.1.1.2 . 10.1.1.2 comment "RY4KD2S4NAOI5GQI" }`,
+			`add element ip crio-hostports hostports { tcp . 8080 comment "RY4KD2S4NAOI5GQI" : 10.1.1.2 . 8080 }`,
+		})
+
+		Expect(manager.Remove("v6", mappings)).To(Succeed())
+		Expect(manager.Remove("v4", mappings)).To(Succeed())
+		Expect(manager.Reservations()).To(BeEmpty())
+	})
+
+	It("should accept forwarded IPv6 traffic on direct routing with nftables", func() {
+		nft6 := knftables.NewFake(knftables.IPv6Family, hostPortsTable)
+
+		manager := newMetaHostportManagerInternal(
+			nil,
+			nil,
+			nil,
+			&hostportManagerNFTables{nft: nft6, family: knftables.IPv6Family},
+		)
+		manager.ipv6DirectRouting = true
+
+		mappings := []*PortMapping{
+			{HostPort: 8080, ContainerPort: 8080, Protocol: v1.ProtocolTCP},
+			{HostPort: 10000, HostPortEnd: 10100, ContainerPort: 10000, Protocol: v1.ProtocolUDP},
+		}
+
+		// No NAT66 rules are installed
+		Expect(manager.Add("v6", "v6_ns", "2001:beef::2", mappings)).To(Succeed())
+		checkNFTablesElements(nft6, []string{
+			`add element ip6 crio-hostports directroutes { 2001:beef::2 . tcp . 8080 comment "H2BIMBCDRCEG5YEH" }`,
+			`add element ip6 crio-hostports directroutes { 2001:beef::2 . udp . 10000-10100 comment "H2BIMBCDRCEG5YEH" }`,
+		})
+
+		Expect(manager.Remove("v6", mappings)).To(Succeed())
+		Expect(manager.Reservations()).To(BeEmpty())
+		checkNFTablesElements(nft6, nil)
+	})
+
+	It("should accept forwarded IPv6 traffic on direct routing with iptables", func() {
+		ip6tables := newFakeIPTables()
+		ip6tables.protocol = utiliptables.ProtocolIPv6
+
+		manager := newMetaHostportManagerInternal(
+			nil,
+			&hostportManagerIPTables{iptables: ip6tables},
+			nil,
+			nil,
+		)
+		manager.ipv6DirectRouting = true
+
+		mappings := []*PortMapping{
+			{HostPort: 8080, ContainerPort: 8080, Protocol: v1.ProtocolTCP},
+		}
+
+		forwardRules := func() []string {
+			raw := bytes.NewBuffer(nil)
+			Expect(ip6tables.SaveInto(utiliptables.TableFilter, raw)).To(Succeed())
+
+			rules := []string{}
+			for line := range strings.SplitSeq(raw.String(), "\n") {
+				if strings.HasPrefix(line, "-A CRIO-") {
+					rules = append(rules, line)
+				}
+			}
+
+			return rules
+		}
+
+		// No NAT66 rules are installed
+		Expect(manager.Add("v6", "v6_ns", "2001:beef::2", mappings)).To(Succeed())
+		checkIPTablesRules(ip6tables, nil)
+		Expect(forwardRules()).To(ConsistOf(
+			`-A CRIO-HOSTPORTS-FWD -m comment --comment "v6_ns hostport 8080" -m tcp -p tcp -d 2001:beef::2/128 --dport 8080 -j CRIO-FWD-WOFHN7VP4GDE3ANW`,
+			`-A CRIO-FWD-WOFHN7VP4GDE3ANW -m comment --comment "v6_ns hostport 8080" -j ACCEPT`,
+		))
+
+		_, _, err := ip6tables.getChain(utiliptables.TableFilter, utiliptables.ChainForward)
+		Expect(err).NotTo(HaveOccurred())
+
+		// The rules of unknown pods get reconciled
+		Expect(manager.Remove("v6", nil)).To(Succeed())
+		Expect(manager.Reconcile(nil)).To(Succeed())
+		Expect(forwardRules()).To(BeEmpty())
+	})
+
+	It("should reject different host and container ports on IPv6 direct routing", func() {
+		nft4 := knftables.NewFake(knftables.IPv4Family, hostPortsTable)
+
+		manager := newMetaHostportManagerInternal(
+			nil,
+			nil,
+			&hostportManagerNFTables{nft: nft4, family: knftables.IPv4Family},
+			nil,
+		)
+		manager.ipv6DirectRouting = true
+
+		err := manager.Add("v6", "v6_ns", "2001:beef::2", []*PortMapping{
+			{HostPort: 8080, ContainerPort: 80, Protocol: v1.ProtocolTCP},
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(manager.Reservations()).To(BeEmpty())
+	})
 })
@@ -25,3 +25,15 @@ func (mh *noopHostportManager) Remove(id string, hostportMappings []*PortMapping
 
 	return nil
 }
+
+func (mh *noopHostportManager) Restore(id, name, podIP string, hostportMappings []*PortMapping) error {
+	return nil
+}
+
+func (mh *noopHostportManager) Reservations() []Reservation {
+	return []Reservation{}
+}
+
+func (mh *noopHostportManager) Reconcile(pods []PodPortMappings) error {
+	return nil
+}
@@ -15,5 +15,10 @@ var _ = t.Describe("NoopHostportManager", func() {
 
 		err = manager.Remove("id", nil)
 		Expect(err).NotTo(HaveOccurred())
+
+		err = manager.Restore("id", "pod1", "1.2.3.4", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(manager.Reservations()).To(BeEmpty())
+		Expect(manager.Reconcile(nil)).To(Succeed())
 	})
 })
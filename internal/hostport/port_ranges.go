@@ -0,0 +1,103 @@
+package hostport
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// ParsePortRanges parses a comma separated list of port range mappings in the
+// format `[<hostIP>:]<firstPort>[-<lastPort>][/<protocol>]`, for example
+// `10000-20000/udp` or `[fd00::1]:5060/sctp`. The protocol defaults to TCP and
+// IPv6 host IPs have to be enclosed in square brackets. The host ports of a
+// range are mapped to the same ports of the container.
+func ParsePortRanges(value string) ([]*PortMapping, error) {
+	res := []*PortMapping{}
+
+	for item := range strings.SplitSeq(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		pm, err := parsePortRange(item)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port range %q: %w", item, err)
+		}
+
+		res = append(res, pm)
+	}
+
+	return res, nil
+}
+
+func parsePortRange(item string) (*PortMapping, error) {
+	pm := &PortMapping{Protocol: v1.ProtocolTCP}
+
+	ports, protocol, found := strings.Cut(item, "/")
+	if found {
+		switch p := v1.Protocol(strings.ToUpper(protocol)); p {
+		case v1.ProtocolTCP, v1.ProtocolUDP, v1.ProtocolSCTP:
+			pm.Protocol = p
+		default:
+			return nil, fmt.Errorf("unsupported protocol %q", protocol)
+		}
+	}
+
+	if strings.Contains(ports, ":") {
+		hostIP, hostPorts, err := net.SplitHostPort(ports)
+		if err != nil {
+			return nil, err
+		}
+
+		if net.ParseIP(hostIP) == nil {
+			return nil, fmt.Errorf("invalid host IP %q", hostIP)
+		}
+
+		pm.HostIP = hostIP
+		ports = hostPorts
+	}
+
+	first, last, isRange := strings.Cut(ports, "-")
+
+	firstPort, err := parsePort(first)
+	if err != nil {
+		return nil, err
+	}
+
+	pm.HostPort = firstPort
+	pm.ContainerPort = firstPort
+
+	if isRange {
+		lastPort, err := parsePort(last)
+		if err != nil {
+			return nil, err
+		}
+
+		if lastPort < firstPort {
+			return nil, fmt.Errorf("last port %d is lower than first port %d", lastPort, firstPort)
+		}
+
+		if lastPort > firstPort {
+			pm.HostPortEnd = lastPort
+		}
+	}
+
+	return pm, nil
+}
+
+func parsePort(port string) (int32, error) {
+	res, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid port %q", port)
+	}
+
+	if res == 0 {
+		return 0, fmt.Errorf("invalid port %q", port)
+	}
+
+	return int32(res), nil
+}
@@ -0,0 +1,59 @@
+package hostport
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ = t.Describe("ParsePortRanges", func() {
+	DescribeTable("should parse valid port ranges",
+		func(value string, expected []*PortMapping) {
+			// Given
+			// When
+			res, err := ParsePortRanges(value)
+
+			// Then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res).To(Equal(expected))
+		},
+		Entry("empty", "", []*PortMapping{}),
+		Entry("range with default protocol", "8000-8010", []*PortMapping{
+			{HostPort: 8000, HostPortEnd: 8010, ContainerPort: 8000, Protocol: v1.ProtocolTCP},
+		}),
+		Entry("single port", "5060/sctp", []*PortMapping{
+			{HostPort: 5060, ContainerPort: 5060, Protocol: v1.ProtocolSCTP},
+		}),
+		Entry("single port range", "5060-5060/udp", []*PortMapping{
+			{HostPort: 5060, ContainerPort: 5060, Protocol: v1.ProtocolUDP},
+		}),
+		Entry("multiple ranges", "10000-20000/UDP, 5060/sctp", []*PortMapping{
+			{HostPort: 10000, HostPortEnd: 20000, ContainerPort: 10000, Protocol: v1.ProtocolUDP},
+			{HostPort: 5060, ContainerPort: 5060, Protocol: v1.ProtocolSCTP},
+		}),
+		Entry("IPv4 host IP", "127.0.0.1:8000-8010", []*PortMapping{
+			{HostPort: 8000, HostPortEnd: 8010, ContainerPort: 8000, Protocol: v1.ProtocolTCP, HostIP: "127.0.0.1"},
+		}),
+		Entry("IPv6 host IP", "[fd00::1]:8000-8010/udp", []*PortMapping{
+			{HostPort: 8000, HostPortEnd: 8010, ContainerPort: 8000, Protocol: v1.ProtocolUDP, HostIP: "fd00::1"},
+		}),
+	)
+
+	DescribeTable("should fail on invalid port ranges",
+		func(value string) {
+			// Given
+			// When
+			_, err := ParsePortRanges(value)
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("invalid protocol", "8000-8010/icmp"),
+		Entry("invalid port", "http"),
+		Entry("zero port", "0-10"),
+		Entry("port out of range", "65000-65536"),
+		Entry("descending range", "8010-8000"),
+		Entry("invalid host IP", "localhost:8000-8010"),
+		Entry("unbracketed IPv6 host IP", "fd00::1:8000-8010"),
+	)
+})
@@ -0,0 +1,193 @@
+package hostport
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	utilnet "k8s.io/utils/net"
+)
+
+// ErrHostPortConflict is returned if a port mapping conflicts with the
+// mapping of another pod.
+var ErrHostPortConflict = errors.New("host port conflict")
+
+// Reservation is a host port claimed by a pod.
+type Reservation struct {
+	// PodID is the ID of the pod sandbox owning the reservation.
+	PodID string
+
+	// PodName is the human-readable name of the pod.
+	PodName string
+
+	// Family is the IP family of the reservation.
+	Family utilnet.IPFamily
+
+	// HostIP is the host IP of the port mapping. An empty value refers to
+	// all host IPs of the family.
+	HostIP string
+
+	// HostPort is the reserved port on the host.
+	HostPort int32
+
+	// HostPortEnd is the last reserved port of a port range, or zero for a
+	// single port.
+	HostPortEnd int32
+
+	// Protocol is the reserved protocol.
+	Protocol v1.Protocol
+}
+
+// overlaps returns true if both reservations claim the same host port.
+func (r *Reservation) overlaps(other *Reservation) bool {
+	if r.Family != other.Family || r.Protocol != other.Protocol ||
+		r.HostPort > other.lastHostPort() || other.HostPort > r.lastHostPort() {
+		return false
+	}
+
+	if isUnspecifiedIP(r.HostIP) || isUnspecifiedIP(other.HostIP) {
+		return true
+	}
+
+	return net.ParseIP(r.HostIP).Equal(net.ParseIP(other.HostIP))
+}
+
+// lastHostPort returns the last host port covered by the reservation.
+func (r *Reservation) lastHostPort() int32 {
+	return max(r.HostPort, r.HostPortEnd)
+}
+
+// matches returns true if the reservation belongs to the provided mapping.
+func (r *Reservation) matches(pm *PortMapping) bool {
+	return r.HostPort == pm.HostPort && r.lastHostPort() == pm.lastHostPort() &&
+		r.Protocol == pm.Protocol && r.HostIP == pm.HostIP
+}
+
+func isUnspecifiedIP(ip string) bool {
+	if ip == "" {
+		return true
+	}
+
+	parsed := net.ParseIP(ip)
+
+	return parsed != nil && parsed.IsUnspecified()
+}
+
+// reservations is the table of host ports claimed by pods.
+type reservations struct {
+	mutex sync.Mutex
+	pods  map[string][]*Reservation
+}
+
+func newReservations() *reservations {
+	return &reservations{pods: make(map[string][]*Reservation)}
+}
+
+// reserve claims the provided mappings for the pod and returns an
+// ErrHostPortConflict if any of them is already claimed by another pod.
+// Nothing gets reserved in case of an error.
+func (r *reservations) reserve(id, name string, family utilnet.IPFamily, hostportMappings []*PortMapping) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	added := make([]*Reservation, 0, len(hostportMappings))
+
+	for _, pm := range hostportMappings {
+		reservation := &Reservation{
+			PodID:       id,
+			PodName:     name,
+			Family:      family,
+			HostIP:      pm.HostIP,
+			HostPort:    pm.HostPort,
+			HostPortEnd: pm.HostPortEnd,
+			Protocol:    pm.Protocol,
+		}
+
+		for podID, existing := range r.pods {
+			if podID == id {
+				continue
+			}
+
+			for _, other := range existing {
+				if reservation.overlaps(other) {
+					hostIP := pm.HostIP
+					if hostIP == "" {
+						hostIP = "*"
+					}
+
+					return fmt.Errorf(
+						"%w: host port %s/%s is already reserved by pod %s (%s)",
+						ErrHostPortConflict, net.JoinHostPort(hostIP, pm.hostPortString("-")), pm.Protocol, other.PodName, other.PodID,
+					)
+				}
+			}
+		}
+
+		if !slices.ContainsFunc(r.pods[id], func(other *Reservation) bool {
+			return other.Family == family && other.matches(pm)
+		}) {
+			added = append(added, reservation)
+		}
+	}
+
+	r.pods[id] = append(r.pods[id], added...)
+
+	return nil
+}
+
+// release removes the reservations of the pod for the provided mappings.
+// All reservations of the pod get released if no mappings are provided.
+func (r *reservations) release(id string, family utilnet.IPFamily, hostportMappings []*PortMapping) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	remaining := slices.DeleteFunc(r.pods[id], func(reservation *Reservation) bool {
+		if family != "" && reservation.Family != family {
+			return false
+		}
+
+		if len(hostportMappings) == 0 {
+			return true
+		}
+
+		return slices.ContainsFunc(hostportMappings, reservation.matches)
+	})
+
+	if len(remaining) == 0 {
+		delete(r.pods, id)
+
+		return
+	}
+
+	r.pods[id] = remaining
+}
+
+// list returns a sorted copy of all reservations.
+func (r *reservations) list() []Reservation {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	res := []Reservation{}
+
+	for _, reservations := range r.pods {
+		for _, reservation := range reservations {
+			res = append(res, *reservation)
+		}
+	}
+
+	slices.SortFunc(res, func(a, b Reservation) int {
+		return cmp.Or(
+			cmp.Compare(a.Family, b.Family),
+			cmp.Compare(a.HostPort, b.HostPort),
+			cmp.Compare(a.Protocol, b.Protocol),
+			cmp.Compare(a.HostIP, b.HostIP),
+			cmp.Compare(a.PodID, b.PodID),
+		)
+	})
+
+	return res
+}
@@ -0,0 +1,136 @@
+package hostport
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	utilnet "k8s.io/utils/net"
+)
+
+var _ = t.Describe("Reservations", func() {
+	var sut *reservations
+
+	BeforeEach(func() {
+		sut = newReservations()
+	})
+
+	tcpMapping := func(hostIP string, hostPort int32) []*PortMapping {
+		return []*PortMapping{{
+			HostIP:        hostIP,
+			HostPort:      hostPort,
+			ContainerPort: 80,
+			Protocol:      v1.ProtocolTCP,
+		}}
+	}
+
+	DescribeTable("should detect conflicts",
+		func(hostIP, otherHostIP string, otherProtocol v1.Protocol, otherFamily utilnet.IPFamily, conflict bool) {
+			// Given
+			Expect(sut.reserve("1", "pod1", utilnet.IPv4, tcpMapping(hostIP, 8080))).To(Succeed())
+
+			// When
+			err := sut.reserve("2", "pod2", otherFamily, []*PortMapping{{
+				HostIP:   otherHostIP,
+				HostPort: 8080,
+				Protocol: otherProtocol,
+			}})
+
+			// Then
+			if conflict {
+				Expect(err).To(HaveOccurred())
+				Expect(errors.Is(err, ErrHostPortConflict)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("pod1"))
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+			}
+		},
+		Entry("same host IP", "127.0.0.1", "127.0.0.1", v1.ProtocolTCP, utilnet.IPv4, true),
+		Entry("all host IPs", "", "", v1.ProtocolTCP, utilnet.IPv4, true),
+		Entry("all host IPs and specific one", "", "127.0.0.1", v1.ProtocolTCP, utilnet.IPv4, true),
+		Entry("unspecified host IP", "0.0.0.0", "127.0.0.1", v1.ProtocolTCP, utilnet.IPv4, true),
+		Entry("different host IPs", "127.0.0.1", "127.0.0.2", v1.ProtocolTCP, utilnet.IPv4, false),
+		Entry("different protocols", "", "", v1.ProtocolUDP, utilnet.IPv4, false),
+		Entry("different families", "", "", v1.ProtocolTCP, utilnet.IPv6, false),
+	)
+
+	DescribeTable("should detect port range conflicts",
+		func(hostPort, hostPortEnd int32, conflict bool) {
+			// Given
+			Expect(sut.reserve("1", "pod1", utilnet.IPv4, []*PortMapping{{
+				HostPort:      10000,
+				HostPortEnd:   10100,
+				ContainerPort: 10000,
+				Protocol:      v1.ProtocolUDP,
+			}})).To(Succeed())
+
+			// When
+			err := sut.reserve("2", "pod2", utilnet.IPv4, []*PortMapping{{
+				HostPort:    hostPort,
+				HostPortEnd: hostPortEnd,
+				Protocol:    v1.ProtocolUDP,
+			}})
+
+			// Then
+			if conflict {
+				Expect(errors.Is(err, ErrHostPortConflict)).To(BeTrue())
+			} else {
+				Expect(err).NotTo(HaveOccurred())
+			}
+		},
+		Entry("single port within range", int32(10050), int32(0), true),
+		Entry("first port of range", int32(10000), int32(0), true),
+		Entry("last port of range", int32(10100), int32(0), true),
+		Entry("overlapping range", int32(9000), int32(10000), true),
+		Entry("enclosing range", int32(9000), int32(11000), true),
+		Entry("port before range", int32(9999), int32(0), false),
+		Entry("range after range", int32(10101), int32(10200), false),
+	)
+
+	It("should allow the same pod to reserve twice", func() {
+		// Given
+		Expect(sut.reserve("1", "pod1", utilnet.IPv4, tcpMapping("", 8080))).To(Succeed())
+
+		// When
+		err := sut.reserve("1", "pod1", utilnet.IPv4, tcpMapping("", 8080))
+
+		// Then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sut.list()).To(HaveLen(1))
+	})
+
+	It("should not reserve anything on conflict", func() {
+		// Given
+		Expect(sut.reserve("1", "pod1", utilnet.IPv4, tcpMapping("", 8080))).To(Succeed())
+
+		// When
+		err := sut.reserve("2", "pod2", utilnet.IPv4, append(tcpMapping("", 8081), tcpMapping("", 8080)...))
+
+		// Then
+		Expect(err).To(HaveOccurred())
+		Expect(sut.list()).To(HaveLen(1))
+	})
+
+	It("should release reservations", func() {
+		// Given
+		Expect(sut.reserve("1", "pod1", utilnet.IPv4, tcpMapping("", 8080))).To(Succeed())
+		Expect(sut.reserve("1", "pod1", utilnet.IPv6, tcpMapping("", 8080))).To(Succeed())
+
+		// When
+		sut.release("1", utilnet.IPv6, tcpMapping("", 8080))
+
+		// Then
+		Expect(sut.list()).To(Equal([]Reservation{{
+			PodID:    "1",
+			PodName:  "pod1",
+			Family:   utilnet.IPv4,
+			HostPort: 8080,
+			Protocol: v1.ProtocolTCP,
+		}}))
+
+		sut.release("1", "", nil)
+		Expect(sut.list()).To(BeEmpty())
+		Expect(sut.reserve("2", "pod2", utilnet.IPv4, tcpMapping("", 8080))).To(Succeed())
+	})
+})
@@ -0,0 +1,104 @@
+package sandbox
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	json "github.com/goccy/go-json"
+
+	pkgtypes "github.com/cri-o/cri-o/pkg/types"
+)
+
+// sbNetworkStateFilename is the name of the file in the infra container's
+// persistent dir storing the network state of the sandbox.
+const sbNetworkStateFilename = "network-state.json"
+
+// NetworkState returns the network state of the sandbox, or nil if the network
+// has not been set up.
+func (s *Sandbox) NetworkState() *pkgtypes.NetworkState {
+	s.stateMutex.RLock()
+	defer s.stateMutex.RUnlock()
+
+	return s.networkState
+}
+
+// SetNetworkState sets the network state of the sandbox. It does not persist
+// the state, which is done by SaveNetworkState.
+func (s *Sandbox) SetNetworkState(state *pkgtypes.NetworkState) {
+	s.stateMutex.Lock()
+	defer s.stateMutex.Unlock()
+
+	s.networkState = state
+}
+
+// SaveNetworkState persists the network state of the sandbox in the infra
+// container's persistent dir, so that it can be restored after a restart
+// without calling the network backend again.
+func (s *Sandbox) SaveNetworkState() error {
+	s.stateMutex.RLock()
+	defer s.stateMutex.RUnlock()
+
+	infra := s.InfraContainer()
+	if s.networkState == nil || infra == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(s.networkState)
+	if err != nil {
+		return fmt.Errorf("marshal network state: %w", err)
+	}
+
+	tmpPath := filepath.Join(infra.Dir(), "."+sbNetworkStateFilename)
+	if err := os.WriteFile(tmpPath, data, 0o600); err != nil {
+		return fmt.Errorf("write network state: %w", err)
+	}
+
+	return os.Rename(tmpPath, filepath.Join(infra.Dir(), sbNetworkStateFilename))
+}
+
+// RestoreNetworkState reads the persisted network state of the sandbox and
+// sets it. It returns nil if no state has been persisted.
+func (s *Sandbox) RestoreNetworkState() (*pkgtypes.NetworkState, error) {
+	infra := s.InfraContainer()
+	if infra == nil {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(filepath.Join(infra.Dir(), sbNetworkStateFilename))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("read network state: %w", err)
+	}
+
+	state := &pkgtypes.NetworkState{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("unmarshal network state: %w", err)
+	}
+
+	s.SetNetworkState(state)
+
+	return state, nil
+}
+
+// RemoveNetworkState unsets the network state of the sandbox and removes the
+// persisted one, if any.
+func (s *Sandbox) RemoveNetworkState() error {
+	s.stateMutex.Lock()
+	defer s.stateMutex.Unlock()
+
+	s.networkState = nil
+
+	infra := s.InfraContainer()
+	if infra == nil {
+		return nil
+	}
+
+	if err := os.Remove(filepath.Join(infra.Dir(), sbNetworkStateFilename)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove network state: %w", err)
+	}
+
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/cri-o/cri-o/internal/log"
 	"github.com/cri-o/cri-o/internal/memorystore"
 	"github.com/cri-o/cri-o/internal/oci"
+	pkgtypes "github.com/cri-o/cri-o/pkg/types"
 )
 
 // DevShmPath is the default system wide shared memory path.
@@ -61,8 +62,10 @@ type Sandbox struct {
 	dnsConfig          *types.DNSConfig
 	stopMutex          sync.RWMutex
 	// stateMutex protects the use of created, stopped and networkStopped bools
-	// which are all fields that can change at runtime
+	// as well as the networkState, which are all fields that can change at
+	// runtime
 	stateMutex        sync.RWMutex
+	networkState      *pkgtypes.NetworkState
 	created           bool
 	stopped           bool
 	networkStopped    bool
@@ -14,6 +14,7 @@ import (
 	"github.com/cri-o/cri-o/internal/oci"
 	"github.com/cri-o/cri-o/internal/storage"
 	"github.com/cri-o/cri-o/internal/storage/references"
+	pkgtypes "github.com/cri-o/cri-o/pkg/types"
 )
 
 // The actual test suite.
@@ -324,6 +325,65 @@ var _ = t.Describe("Sandbox", func() {
 			Expect(testSandbox.ContainerEnvPath()).To(ContainSubstring(".containerenv"))
 		})
 	})
+
+	t.Describe("NetworkState", func() {
+		BeforeEach(func() {
+			infra, err := oci.NewContainer("testid", "testname", "",
+				"/container/logs", map[string]string{},
+				map[string]string{}, map[string]string{}, "image",
+				nil, nil, "", &types.ContainerMetadata{},
+				"testsandboxid", false, false, false, "",
+				t.MustTempDir("infra"), time.Now(), "SIGKILL")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testSandbox.SetInfraContainer(infra)).To(Succeed())
+		})
+
+		It("should succeed to save and restore", func() {
+			// Given
+			state := &pkgtypes.NetworkState{
+				Backend: "cni",
+				Network: "crio",
+				IPs:     []string{"10.88.0.2", "fd00::2"},
+				Routes:  []pkgtypes.NetworkRoute{{Destination: "0.0.0.0/0", Gateway: "10.88.0.1"}},
+			}
+			testSandbox.SetNetworkState(state)
+
+			// When
+			Expect(testSandbox.SaveNetworkState()).To(Succeed())
+			testSandbox.SetNetworkState(nil)
+			res, err := testSandbox.RestoreNetworkState()
+
+			// Then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(Equal(state))
+			Expect(testSandbox.NetworkState()).To(Equal(state))
+		})
+
+		It("should restore nothing if not saved", func() {
+			// When
+			res, err := testSandbox.RestoreNetworkState()
+
+			// Then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(BeNil())
+		})
+
+		It("should succeed to remove", func() {
+			// Given
+			testSandbox.SetNetworkState(&pkgtypes.NetworkState{IPs: []string{"10.88.0.2"}})
+			Expect(testSandbox.SaveNetworkState()).To(Succeed())
+
+			// When
+			err := testSandbox.RemoveNetworkState()
+
+			// Then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(testSandbox.NetworkState()).To(BeNil())
+			res, err := testSandbox.RestoreNetworkState()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res).To(BeNil())
+		})
+	})
 	t.Describe("NeedsInfra", func() {
 		It("should not need when managing NS and NS mode NODE", func() {
 			// Given
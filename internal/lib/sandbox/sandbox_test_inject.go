@@ -6,6 +6,8 @@
 package sandbox
 
 import (
+	types "k8s.io/cri-api/pkg/apis/runtime/v1"
+
 	"github.com/cri-o/cri-o/internal/hostport"
 )
 
@@ -13,3 +15,12 @@ import (
 func (s *Sandbox) SetPortMappings(portMappings []*hostport.PortMapping) {
 	s.portMappings = portMappings
 }
+
+// SetAnnotations sets the CRI annotations for the Sandbox.
+func (s *Sandbox) SetAnnotations(annotations map[string]string) {
+	if s.criSandbox == nil {
+		s.criSandbox = &types.PodSandbox{}
+	}
+
+	s.criSandbox.Annotations = annotations
+}
@@ -27,6 +27,12 @@ func GetDiskUsageForPath(path string) (*DiskStats, error) {
 		return nil, fmt.Errorf("failed to get disk usage stats: %w", err)
 	}
 
+	return GetDiskStatsForUsage(path, usageBytes)
+}
+
+// GetDiskStatsForUsage returns disk usage statistics for a given path, using
+// the provided usage instead of walking the path.
+func GetDiskStatsForUsage(path string, usageBytes uint64) (*DiskStats, error) {
 	var stat syscall.Statfs_t
 	if err := syscall.Statfs(path, &stat); err != nil {
 		return nil, fmt.Errorf("failed to get filesystem stats: %w", err)
@@ -0,0 +1,111 @@
+package statsserver
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cri-o/cri-o/internal/lib/sandbox"
+	"github.com/cri-o/cri-o/internal/oci"
+	"github.com/cri-o/cri-o/server/metrics"
+)
+
+// statsType is the kind of a cached stats or metrics entry.
+type statsType string
+
+const (
+	statsTypeSandboxStats   statsType = "sandbox_stats"
+	statsTypeContainerStats statsType = "container_stats"
+	statsTypeSandboxMetrics statsType = "sandbox_metrics"
+)
+
+const (
+	cacheResultHit  = "hit"
+	cacheResultMiss = "miss"
+)
+
+type cacheKey struct {
+	statsType statsType
+	id        string
+}
+
+// cacheEntry records when a stats or metrics entry got collected, and the
+// state of the entity at that time.
+type cacheEntry struct {
+	collected   time.Time
+	fingerprint string
+}
+
+// cached returns true if the existing entry of the provided type and ID can be
+// served from the cache. Without a cache TTL, every existing entry is valid,
+// because they are refreshed by the update loop. With a cache TTL, entries are
+// only valid until they expire or the entity changed since their collection.
+// Note: caller must hold the lock on the StatsServer.
+func (ss *StatsServer) cached(t statsType, id string, exists bool, fingerprint func() string) bool {
+	hit := exists
+	if hit && ss.cacheTTL > 0 {
+		entry, ok := ss.cache[cacheKey{t, id}]
+		hit = ok && time.Since(entry.collected) < ss.cacheTTL && entry.fingerprint == fingerprint()
+	}
+
+	result := cacheResultMiss
+	if hit {
+		result = cacheResultHit
+	}
+
+	metrics.Instance().MetricStatsCacheRequestsInc(string(t), result)
+
+	return hit
+}
+
+// collected records the collection cost of an entry of the provided type and
+// ID, which got collected since start, and caches it.
+// Note: caller must hold the lock on the StatsServer.
+func (ss *StatsServer) collected(t statsType, id, fingerprint string, start time.Time) {
+	metrics.Instance().MetricStatsCollectionDurationObserve(string(t), start)
+	ss.record(t, id, fingerprint, start)
+}
+
+// record caches an entry of the provided type and ID, which got collected at
+// the provided time.
+// Note: caller must hold the lock on the StatsServer.
+func (ss *StatsServer) record(t statsType, id, fingerprint string, collectedAt time.Time) {
+	if ss.cacheTTL == 0 {
+		return
+	}
+
+	ss.cache[cacheKey{t, id}] = cacheEntry{collected: collectedAt, fingerprint: fingerprint}
+}
+
+// uncache removes the cache entries of the provided types and ID.
+// Note: caller must hold the lock on the StatsServer.
+func (ss *StatsServer) uncache(id string, statsTypes ...statsType) {
+	for _, t := range statsTypes {
+		delete(ss.cache, cacheKey{t, id})
+	}
+}
+
+// sandboxFingerprint identifies the set of running containers of a sandbox,
+// which invalidates the cached sandbox entries if a container got added,
+// started or stopped.
+func sandboxFingerprint(sb *sandbox.Sandbox) string {
+	ids := []string{}
+
+	for _, c := range sb.Containers().List() {
+		if c.StateNoLock().Status != oci.ContainerStateStopped {
+			ids = append(ids, c.ID())
+		}
+	}
+
+	slices.Sort(ids)
+
+	return strings.Join(ids, ",")
+}
+
+// containerFingerprint identifies the state of a container, which invalidates
+// the cached container entry if it got restarted or stopped.
+func containerFingerprint(c *oci.Container) string {
+	state := c.StateNoLock()
+
+	return string(state.Status) + "/" + state.Started.String()
+}
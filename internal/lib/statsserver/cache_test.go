@@ -0,0 +1,275 @@
+package statsserver
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+
+	"github.com/cri-o/cri-o/internal/oci"
+	"github.com/cri-o/cri-o/server/metrics"
+	"github.com/cri-o/cri-o/server/metrics/collectors"
+)
+
+// The actual test suite.
+var _ = t.Describe("Cache", func() {
+	const id = "id"
+
+	var sut *StatsServer
+
+	newStatsServer := func(ttl time.Duration) *StatsServer {
+		return &StatsServer{cacheTTL: ttl, cache: map[cacheKey]cacheEntry{}}
+	}
+
+	fingerprint := func(value string) func() string {
+		return func() string { return value }
+	}
+
+	requests := func(t statsType, result string) float64 {
+		samples := metrics.Instance().Samples(collectors.StatsCacheRequestsTotal, map[string]string{
+			"type":   string(t),
+			"result": result,
+		})
+		if len(samples) == 0 {
+			return 0
+		}
+
+		return samples[0].GetCounter().GetValue()
+	}
+
+	t.Describe("without a cache TTL", func() {
+		BeforeEach(func() {
+			sut = newStatsServer(0)
+		})
+
+		It("should hit for existing entries", func() {
+			// Given
+			// When
+			res := sut.cached(statsTypeSandboxStats, id, true, fingerprint("a"))
+
+			// Then
+			Expect(res).To(BeTrue())
+		})
+
+		It("should miss for non existing entries", func() {
+			// Given
+			// When
+			res := sut.cached(statsTypeSandboxStats, id, false, fingerprint("a"))
+
+			// Then
+			Expect(res).To(BeFalse())
+		})
+
+		It("should not record entries", func() {
+			// Given
+			// When
+			sut.record(statsTypeSandboxStats, id, "a", time.Now())
+
+			// Then
+			Expect(sut.cache).To(BeEmpty())
+		})
+	})
+
+	t.Describe("with a cache TTL", func() {
+		BeforeEach(func() {
+			sut = newStatsServer(time.Minute)
+		})
+
+		It("should hit for recorded entries", func() {
+			// Given
+			sut.record(statsTypeContainerStats, id, "a", time.Now())
+
+			// When
+			res := sut.cached(statsTypeContainerStats, id, true, fingerprint("a"))
+
+			// Then
+			Expect(res).To(BeTrue())
+		})
+
+		It("should miss for non existing entries", func() {
+			// Given
+			sut.record(statsTypeContainerStats, id, "a", time.Now())
+
+			// When
+			res := sut.cached(statsTypeContainerStats, id, false, fingerprint("a"))
+
+			// Then
+			Expect(res).To(BeFalse())
+		})
+
+		It("should miss for unrecorded entries", func() {
+			// Given
+			// When
+			res := sut.cached(statsTypeContainerStats, id, true, fingerprint("a"))
+
+			// Then
+			Expect(res).To(BeFalse())
+		})
+
+		It("should miss for expired entries", func() {
+			// Given
+			sut.record(statsTypeContainerStats, id, "a", time.Now().Add(-2*time.Minute))
+
+			// When
+			res := sut.cached(statsTypeContainerStats, id, true, fingerprint("a"))
+
+			// Then
+			Expect(res).To(BeFalse())
+		})
+
+		It("should miss if the fingerprint changed", func() {
+			// Given
+			sut.record(statsTypeContainerStats, id, "a", time.Now())
+
+			// When
+			res := sut.cached(statsTypeContainerStats, id, true, fingerprint("b"))
+
+			// Then
+			Expect(res).To(BeFalse())
+		})
+
+		It("should miss for entries of another type", func() {
+			// Given
+			sut.record(statsTypeSandboxStats, id, "a", time.Now())
+
+			// When
+			res := sut.cached(statsTypeSandboxMetrics, id, true, fingerprint("a"))
+
+			// Then
+			Expect(res).To(BeFalse())
+		})
+
+		It("should miss for uncached entries", func() {
+			// Given
+			sut.record(statsTypeSandboxStats, id, "a", time.Now())
+			sut.record(statsTypeSandboxMetrics, id, "a", time.Now())
+
+			// When
+			sut.uncache(id, statsTypeSandboxStats, statsTypeSandboxMetrics)
+
+			// Then
+			Expect(sut.cache).To(BeEmpty())
+			Expect(sut.cached(statsTypeSandboxStats, id, true, fingerprint("a"))).To(BeFalse())
+			Expect(sut.cached(statsTypeSandboxMetrics, id, true, fingerprint("a"))).To(BeFalse())
+		})
+
+		It("should only uncache the provided types", func() {
+			// Given
+			sut.record(statsTypeSandboxStats, id, "a", time.Now())
+			sut.record(statsTypeSandboxMetrics, id, "a", time.Now())
+
+			// When
+			sut.uncache(id, statsTypeSandboxStats)
+
+			// Then
+			Expect(sut.cached(statsTypeSandboxMetrics, id, true, fingerprint("a"))).To(BeTrue())
+		})
+
+		It("should cache collected entries", func() {
+			// Given
+			// When
+			sut.collected(statsTypeContainerStats, id, "a", time.Now())
+
+			// Then
+			Expect(sut.cached(statsTypeContainerStats, id, true, fingerprint("a"))).To(BeTrue())
+		})
+	})
+
+	t.Describe("metrics", func() {
+		BeforeEach(func() {
+			sut = newStatsServer(time.Minute)
+		})
+
+		It("should count cache hits", func() {
+			// Given
+			sut.record(statsTypeSandboxMetrics, id, "a", time.Now())
+			hits := requests(statsTypeSandboxMetrics, cacheResultHit)
+			misses := requests(statsTypeSandboxMetrics, cacheResultMiss)
+
+			// When
+			sut.cached(statsTypeSandboxMetrics, id, true, fingerprint("a"))
+
+			// Then
+			Expect(requests(statsTypeSandboxMetrics, cacheResultHit)).To(Equal(hits + 1))
+			Expect(requests(statsTypeSandboxMetrics, cacheResultMiss)).To(Equal(misses))
+		})
+
+		It("should count cache misses", func() {
+			// Given
+			sut.record(statsTypeSandboxMetrics, id, "a", time.Now().Add(-2*time.Minute))
+			hits := requests(statsTypeSandboxMetrics, cacheResultHit)
+			misses := requests(statsTypeSandboxMetrics, cacheResultMiss)
+
+			// When
+			sut.cached(statsTypeSandboxMetrics, id, true, fingerprint("a"))
+
+			// Then
+			Expect(requests(statsTypeSandboxMetrics, cacheResultHit)).To(Equal(hits))
+			Expect(requests(statsTypeSandboxMetrics, cacheResultMiss)).To(Equal(misses + 1))
+		})
+	})
+
+	t.Describe("fingerprints", func() {
+		newContainer := func(id string, status string, started time.Time) *oci.Container {
+			c := oci.NewSpoofedContainer(id, id, map[string]string{}, "sandboxID", time.Now(), "")
+			c.SetState(&oci.ContainerState{
+				State:   specs.State{Status: specs.ContainerState(status)},
+				Started: started,
+			})
+
+			return c
+		}
+
+		It("should change the sandbox fingerprint if a container got added", func() {
+			// Given
+			sb := newTestSandbox()
+			before := sandboxFingerprint(sb)
+
+			// When
+			sb.AddContainer(context.Background(), newContainer("ctr", oci.ContainerStateRunning, time.Now()))
+
+			// Then
+			Expect(sandboxFingerprint(sb)).NotTo(Equal(before))
+		})
+
+		It("should ignore stopped containers in the sandbox fingerprint", func() {
+			// Given
+			sb := newTestSandbox()
+			sb.AddContainer(context.Background(), newContainer("b", oci.ContainerStateRunning, time.Now()))
+			sb.AddContainer(context.Background(), newContainer("a", oci.ContainerStateRunning, time.Now()))
+			sb.AddContainer(context.Background(), newContainer("c", oci.ContainerStateStopped, time.Now()))
+
+			// When
+			res := sandboxFingerprint(sb)
+
+			// Then
+			Expect(res).To(Equal("a,b"))
+		})
+
+		It("should change the container fingerprint if it got restarted", func() {
+			// Given
+			started := time.Now()
+			before := containerFingerprint(newContainer("ctr", oci.ContainerStateRunning, started))
+
+			// When
+			res := containerFingerprint(newContainer("ctr", oci.ContainerStateRunning, started.Add(time.Second)))
+
+			// Then
+			Expect(res).NotTo(Equal(before))
+		})
+
+		It("should change the container fingerprint if it got stopped", func() {
+			// Given
+			started := time.Now()
+			before := containerFingerprint(newContainer("ctr", oci.ContainerStateRunning, started))
+
+			// When
+			res := containerFingerprint(newContainer("ctr", oci.ContainerStateStopped, started))
+
+			// Then
+			Expect(res).NotTo(Equal(before))
+		})
+	})
+})
@@ -202,6 +202,16 @@ var (
 		Help:      "Cumulative count of errors encountered while transmitting",
 		LabelKeys: networkLabelKeys,
 	}
+	containerNetworkSockets = &types.MetricDescriptor{
+		Name:      "container_network_sockets",
+		Help:      "Number of sockets in use in the network namespace by protocol",
+		LabelKeys: append(baseLabelKeys, "protocol"),
+	}
+	containerNetworkSocketDropsTotal = &types.MetricDescriptor{
+		Name:      "container_network_socket_drops_total",
+		Help:      "Cumulative count of packets or connections dropped by sockets in the network namespace by protocol and reason",
+		LabelKeys: append(baseLabelKeys, "protocol", "reason"),
+	}
 )
 
 // OOM metrics.
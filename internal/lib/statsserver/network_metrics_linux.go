@@ -1,6 +1,9 @@
 package statsserver
 
 import (
+	"fmt"
+
+	"github.com/containernetworking/plugins/pkg/ns"
 	"github.com/vishvananda/netlink"
 	types "k8s.io/cri-api/pkg/apis/runtime/v1"
 
@@ -8,27 +11,56 @@ import (
 	"github.com/cri-o/cri-o/internal/log"
 )
 
+// GenerateNetworkMetrics returns the metrics of every interface as well as the
+// socket counts and drops of the sandbox network namespace. Host network
+// sandboxes without an infra container report the host network namespace.
 func (ss *StatsServer) GenerateNetworkMetrics(sb *sandbox.Sandbox) []*types.Metric {
 	var metrics []*types.Metric
 
-	links, err := netlink.LinkList()
-	if err != nil {
-		log.Errorf(ss.ctx, "Unable to retrieve network namespace links %s: %v", sb.ID(), err)
+	collect := func(ns.NetNS) error {
+		links, err := netlink.LinkList()
+		if err != nil {
+			return fmt.Errorf("retrieve network namespace links: %w", err)
+		}
+
+		if len(links) == 0 {
+			log.Warnf(ss.ctx, "Network links are not available for sandbox %s", sb.ID())
+		}
+
+		for i := range links {
+			if attrs := links[i].Attrs(); attrs != nil {
+				networkMetrics := generateSandboxNetworkMetrics(sb, attrs)
+				metrics = append(metrics, networkMetrics...)
+			}
+		}
+
+		socketMetrics, err := generateSandboxSocketMetrics(sb)
+		if err != nil {
+			log.Errorf(ss.ctx, "Unable to retrieve socket metrics for sandbox %s: %v", sb.ID(), err)
+		}
+
+		metrics = append(metrics, socketMetrics...)
 
 		return nil
 	}
 
-	if len(links) == 0 {
-		log.Warnf(ss.ctx, "Network links are not available.")
+	var err error
+
+	switch netNsPath := sb.NetNsPath(); {
+	case netNsPath != "":
+		err = ns.WithNetNSPath(netNsPath, collect)
+	case sb.HostNetwork():
+		err = collect(nil)
+	default:
+		log.Debugf(ss.ctx, "Skipping network metrics for sandbox %s without network namespace", sb.ID())
 
 		return nil
 	}
 
-	for i := range links {
-		if attrs := links[i].Attrs(); attrs != nil {
-			networkMetrics := generateSandboxNetworkMetrics(sb, attrs)
-			metrics = append(metrics, networkMetrics...)
-		}
+	if err != nil {
+		log.Errorf(ss.ctx, "Unable to generate network metrics for sandbox %s: %v", sb.ID(), err)
+
+		return nil
 	}
 
 	return metrics
@@ -45,6 +77,7 @@ func generateSandboxNetworkMetrics(sb *sandbox.Sandbox, attr *netlink.LinkAttrs)
 			valueFunc: func() metricValues {
 				return metricValues{{
 					value:      attr.Statistics.RxBytes,
+					labels:     []string{attr.Name},
 					metricType: types.MetricType_COUNTER,
 				}}
 			},
@@ -53,6 +86,7 @@ func generateSandboxNetworkMetrics(sb *sandbox.Sandbox, attr *netlink.LinkAttrs)
 			valueFunc: func() metricValues {
 				return metricValues{{
 					value:      attr.Statistics.RxPackets,
+					labels:     []string{attr.Name},
 					metricType: types.MetricType_COUNTER,
 				}}
 			},
@@ -61,6 +95,7 @@ func generateSandboxNetworkMetrics(sb *sandbox.Sandbox, attr *netlink.LinkAttrs)
 			valueFunc: func() metricValues {
 				return metricValues{{
 					value:      attr.Statistics.RxDropped,
+					labels:     []string{attr.Name},
 					metricType: types.MetricType_COUNTER,
 				}}
 			},
@@ -69,6 +104,7 @@ func generateSandboxNetworkMetrics(sb *sandbox.Sandbox, attr *netlink.LinkAttrs)
 			valueFunc: func() metricValues {
 				return metricValues{{
 					value:      attr.Statistics.RxErrors,
+					labels:     []string{attr.Name},
 					metricType: types.MetricType_COUNTER,
 				}}
 			},
@@ -77,6 +113,7 @@ func generateSandboxNetworkMetrics(sb *sandbox.Sandbox, attr *netlink.LinkAttrs)
 			valueFunc: func() metricValues {
 				return metricValues{{
 					value:      attr.Statistics.TxBytes,
+					labels:     []string{attr.Name},
 					metricType: types.MetricType_COUNTER,
 				}}
 			},
@@ -85,6 +122,7 @@ func generateSandboxNetworkMetrics(sb *sandbox.Sandbox, attr *netlink.LinkAttrs)
 			valueFunc: func() metricValues {
 				return metricValues{{
 					value:      attr.Statistics.TxPackets,
+					labels:     []string{attr.Name},
 					metricType: types.MetricType_COUNTER,
 				}}
 			},
@@ -93,6 +131,7 @@ func generateSandboxNetworkMetrics(sb *sandbox.Sandbox, attr *netlink.LinkAttrs)
 			valueFunc: func() metricValues {
 				return metricValues{{
 					value:      attr.Statistics.TxDropped,
+					labels:     []string{attr.Name},
 					metricType: types.MetricType_COUNTER,
 				}}
 			},
@@ -101,11 +140,12 @@ func generateSandboxNetworkMetrics(sb *sandbox.Sandbox, attr *netlink.LinkAttrs)
 			valueFunc: func() metricValues {
 				return metricValues{{
 					value:      attr.Statistics.TxErrors,
+					labels:     []string{attr.Name},
 					metricType: types.MetricType_COUNTER,
 				}}
 			},
 		},
 	}
 
-	return computeSandboxMetrics(sb, networkMetrics, "network")
+	return computeSandboxMetrics(sb, networkMetrics)
 }
@@ -0,0 +1,27 @@
+package statsserver
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/vishvananda/netlink"
+)
+
+// The actual test suite.
+var _ = t.Describe("NetworkMetrics", func() {
+	It("should label the network metrics with the interface name", func() {
+		// Given
+		sb := newTestSandbox()
+
+		attrs := &netlink.LinkAttrs{Name: "eth1", Statistics: &netlink.LinkStatistics{RxBytes: 42}}
+
+		// When
+		metrics := generateSandboxNetworkMetrics(sb, attrs)
+
+		// Then
+		Expect(metrics).NotTo(BeEmpty())
+
+		for _, m := range metrics {
+			Expect(m.GetLabelValues()).To(Equal([]string{"sandboxID", "POD", "", "eth1"}))
+		}
+	})
+})
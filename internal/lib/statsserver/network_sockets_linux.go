@@ -0,0 +1,220 @@
+package statsserver
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	types "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	"github.com/cri-o/cri-o/internal/lib/sandbox"
+)
+
+// procNetPath is the path to the network statistics of the network namespace
+// of the calling thread. /proc/net refers to the namespace of the thread group
+// leader instead, which is not the one entered by ns.WithNetNSPath.
+const procNetPath = "/proc/thread-self/net"
+
+// socketDrop maps a counter of the /proc/net statistics files to the protocol
+// and reason labels of the socket drops metric.
+type socketDrop struct {
+	protocol, reason string
+	// section is the line prefix in snmp and netstat, or empty for snmp6.
+	section, field string
+}
+
+var socketDrops = []socketDrop{
+	{"tcp", "listen_overflow", "TcpExt", "ListenOverflows"},
+	{"tcp", "listen_drop", "TcpExt", "ListenDrops"},
+	{"tcp", "backlog_drop", "TcpExt", "TCPBacklogDrop"},
+	{"udp", "receive_buffer", "Udp", "RcvbufErrors"},
+	{"udp", "send_buffer", "Udp", "SndbufErrors"},
+	{"udp6", "receive_buffer", "", "Udp6RcvbufErrors"},
+	{"udp6", "send_buffer", "", "Udp6SndbufErrors"},
+}
+
+// socketProtocols are the protocols of the sockstat files reported by the
+// sockets metric, in the case they appear in the files.
+var socketProtocols = map[string]string{
+	"TCP":  "tcp",
+	"UDP":  "udp",
+	"TCP6": "tcp6",
+	"UDP6": "udp6",
+}
+
+// generateSandboxSocketMetrics returns the socket counts and drops of the
+// current network namespace.
+func generateSandboxSocketMetrics(sb *sandbox.Sandbox) ([]*types.Metric, error) {
+	inUse := map[string]uint64{}
+
+	for _, file := range []string{"sockstat", "sockstat6"} {
+		if err := readProcNetFile(file, func(r io.Reader) error {
+			return parseSockstat(r, inUse)
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	counters := map[string]uint64{}
+
+	for _, file := range []string{"snmp", "netstat"} {
+		if err := readProcNetFile(file, func(r io.Reader) error {
+			return parseSectionedCounters(r, counters)
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := readProcNetFile("snmp6", func(r io.Reader) error {
+		return parseKeyValueCounters(r, counters)
+	}); err != nil {
+		return nil, err
+	}
+
+	return computeSandboxMetrics(sb, []*containerMetric{
+		{
+			desc: containerNetworkSockets,
+			valueFunc: func() metricValues {
+				values := make(metricValues, 0, len(inUse))
+				for _, protocol := range []string{"tcp", "tcp6", "udp", "udp6"} {
+					if value, ok := inUse[protocol]; ok {
+						values = append(values, metricValue{
+							value:      value,
+							labels:     []string{protocol},
+							metricType: types.MetricType_GAUGE,
+						})
+					}
+				}
+
+				return values
+			},
+		}, {
+			desc: containerNetworkSocketDropsTotal,
+			valueFunc: func() metricValues {
+				values := make(metricValues, 0, len(socketDrops))
+				for _, d := range socketDrops {
+					if value, ok := counters[d.section+d.field]; ok {
+						values = append(values, metricValue{
+							value:      value,
+							labels:     []string{d.protocol, d.reason},
+							metricType: types.MetricType_COUNTER,
+						})
+					}
+				}
+
+				return values
+			},
+		},
+	}), nil
+}
+
+// readProcNetFile parses the provided file of the current network namespace.
+// Missing files are skipped, because the IPv6 files do not exist if IPv6 is
+// disabled.
+func readProcNetFile(name string, parse func(io.Reader) error) error {
+	f, err := os.Open(filepath.Join(procNetPath, name))
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := parse(f); err != nil {
+		return fmt.Errorf("parse %s: %w", f.Name(), err)
+	}
+
+	return nil
+}
+
+// parseSockstat parses the in use socket counts of the sockstat and sockstat6
+// files, which consist of lines like "TCP: inuse 4 orphan 0 tw 0 alloc 4".
+func parseSockstat(r io.Reader, inUse map[string]uint64) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		name, fields, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+
+		protocol, ok := socketProtocols[name]
+		if !ok {
+			continue
+		}
+
+		kv := strings.Fields(fields)
+		for i := 0; i+1 < len(kv); i += 2 {
+			if kv[i] != "inuse" {
+				continue
+			}
+
+			value, err := strconv.ParseUint(kv[i+1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse %s in use sockets: %w", name, err)
+			}
+
+			inUse[protocol] = value
+		}
+	}
+
+	return scanner.Err()
+}
+
+// parseSectionedCounters parses the snmp and netstat files, which consist of
+// pairs of lines per section: one with the field names and one with the
+// values, both prefixed by the section name. The counters are stored with the
+// section name as key prefix, like "TcpExtListenDrops".
+func parseSectionedCounters(r io.Reader, counters map[string]uint64) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		header := strings.Fields(scanner.Text())
+		if !scanner.Scan() {
+			break
+		}
+
+		values := strings.Fields(scanner.Text())
+		if len(header) == 0 || len(header) != len(values) || header[0] != values[0] {
+			return fmt.Errorf("malformed section %q", strings.Join(header, " "))
+		}
+
+		section := strings.TrimSuffix(header[0], ":")
+
+		for i := 1; i < len(header); i++ {
+			// Negative counters like Tcp MaxConn are skipped, because none
+			// of them are reported.
+			value, err := strconv.ParseUint(values[i], 10, 64)
+			if err != nil {
+				continue
+			}
+
+			counters[section+header[i]] = value
+		}
+	}
+
+	return scanner.Err()
+}
+
+// parseKeyValueCounters parses the snmp6 file, which consists of lines with a
+// counter name and its value.
+func parseKeyValueCounters(r io.Reader, counters map[string]uint64) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		counters[fields[0]] = value
+	}
+
+	return scanner.Err()
+}
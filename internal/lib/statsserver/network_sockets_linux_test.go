@@ -0,0 +1,88 @@
+package statsserver
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// The actual test suite.
+var _ = t.Describe("NetworkSockets", func() {
+	t.Describe("parseSockstat", func() {
+		It("should parse the in use sockets", func() {
+			// Given
+			inUse := map[string]uint64{}
+			sockstat := "sockets: used 18\n" +
+				"TCP: inuse 4 orphan 0 tw 1 alloc 4 mem 0\n" +
+				"UDP: inuse 2 mem 0\n" +
+				"RAW: inuse 1\n"
+			sockstat6 := "TCP6: inuse 3\nUDP6: inuse 0\n"
+
+			// When
+			err := parseSockstat(strings.NewReader(sockstat), inUse)
+			Expect(err).NotTo(HaveOccurred())
+			err = parseSockstat(strings.NewReader(sockstat6), inUse)
+
+			// Then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(inUse).To(Equal(map[string]uint64{"tcp": 4, "udp": 2, "tcp6": 3, "udp6": 0}))
+		})
+
+		It("should fail on invalid values", func() {
+			// Given
+			// When
+			err := parseSockstat(strings.NewReader("TCP: inuse x\n"), map[string]uint64{})
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	t.Describe("parseSectionedCounters", func() {
+		It("should parse the counters per section", func() {
+			// Given
+			counters := map[string]uint64{}
+			snmp := "Tcp: RtoMin MaxConn\n" +
+				"Tcp: 200 -1\n" +
+				"Udp: InDatagrams RcvbufErrors SndbufErrors\n" +
+				"Udp: 26 3 1\n"
+
+			// When
+			err := parseSectionedCounters(strings.NewReader(snmp), counters)
+
+			// Then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(counters).To(Equal(map[string]uint64{
+				"TcpRtoMin":       200,
+				"UdpInDatagrams":  26,
+				"UdpRcvbufErrors": 3,
+				"UdpSndbufErrors": 1,
+			}))
+		})
+
+		It("should fail on mismatching sections", func() {
+			// Given
+			// When
+			err := parseSectionedCounters(strings.NewReader("TcpExt: ListenDrops\nIpExt: 1\n"), map[string]uint64{})
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	t.Describe("parseKeyValueCounters", func() {
+		It("should parse the counters", func() {
+			// Given
+			counters := map[string]uint64{}
+			snmp6 := "Udp6RcvbufErrors                	5\nUdp6SndbufErrors                	0\n"
+
+			// When
+			err := parseKeyValueCounters(strings.NewReader(snmp6), counters)
+
+			// Then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(counters).To(Equal(map[string]uint64{"Udp6RcvbufErrors": 5, "Udp6SndbufErrors": 0}))
+		})
+	})
+})
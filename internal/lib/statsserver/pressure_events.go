@@ -0,0 +1,68 @@
+package statsserver
+
+import (
+	"sync"
+
+	"github.com/cri-o/cri-o/pkg/config"
+	"github.com/cri-o/cri-o/pkg/types"
+)
+
+// pressureEventsBufferSize is the amount of pressure events buffered per
+// subscriber. Events are dropped for subscribers which do not keep up.
+const pressureEventsBufferSize = 100
+
+// pressureKey identifies a pressure threshold of a container.
+type pressureKey struct {
+	containerID string
+	threshold   config.PressureThreshold
+}
+
+// pressureSubscribers fans out the pressure events to all subscribers.
+type pressureSubscribers struct {
+	mutex       sync.Mutex
+	subscribers map[chan *types.PressureEvent]struct{}
+}
+
+// SubscribePressureEvents returns a channel receiving the pressure events of
+// all containers, and a function to cancel the subscription, which closes the
+// channel.
+func (ss *StatsServer) SubscribePressureEvents() (events <-chan *types.PressureEvent, cancel func()) {
+	ch := make(chan *types.PressureEvent, pressureEventsBufferSize)
+
+	ss.pressureSubscribers.mutex.Lock()
+	ss.pressureSubscribers.subscribers[ch] = struct{}{}
+	ss.pressureSubscribers.mutex.Unlock()
+
+	return ch, func() {
+		ss.pressureSubscribers.mutex.Lock()
+		defer ss.pressureSubscribers.mutex.Unlock()
+
+		if _, ok := ss.pressureSubscribers.subscribers[ch]; ok {
+			delete(ss.pressureSubscribers.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// publishPressureEvent sends the event to all subscribers without blocking.
+func (ss *StatsServer) publishPressureEvent(event *types.PressureEvent) {
+	ss.pressureSubscribers.mutex.Lock()
+	defer ss.pressureSubscribers.mutex.Unlock()
+
+	for ch := range ss.pressureSubscribers.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// removePressureState forgets the exceeded thresholds of the container.
+// Note: caller must hold the lock on the StatsServer.
+func (ss *StatsServer) removePressureState(containerID string) {
+	for key := range ss.pressureExceeded {
+		if key.containerID == containerID {
+			delete(ss.pressureExceeded, key)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package statsserver
+
+import (
+	"time"
+
+	"github.com/opencontainers/cgroups"
+
+	"github.com/cri-o/cri-o/internal/lib/stats"
+	"github.com/cri-o/cri-o/internal/log"
+	"github.com/cri-o/cri-o/internal/oci"
+	"github.com/cri-o/cri-o/pkg/config"
+	"github.com/cri-o/cri-o/pkg/types"
+	"github.com/cri-o/cri-o/server/metrics"
+)
+
+// checkPressureThresholds compares the pressure stall values of the container
+// to the configured thresholds, and publishes an event for every threshold the
+// container exceeded or dropped below since the last check.
+// Note: caller must hold the lock on the StatsServer.
+func (ss *StatsServer) checkPressureThresholds(c *oci.Container, cgroupStats *stats.CgroupStats) {
+	if cgroupStats == nil {
+		return
+	}
+
+	for _, threshold := range ss.pressureThresholds {
+		avg10, ok := pressureAvg10(cgroupStats, threshold)
+		if !ok {
+			continue
+		}
+
+		key := pressureKey{containerID: c.ID(), threshold: threshold}
+		exceeded := avg10 > threshold.Avg10
+
+		if exceeded == ss.pressureExceeded[key] {
+			continue
+		}
+
+		eventType := types.PressureEventRecovered
+		if exceeded {
+			eventType = types.PressureEventExceeded
+			ss.pressureExceeded[key] = true
+
+			metrics.Instance().MetricContainersPressureStallEventsInc(threshold.Resource, threshold.Kind)
+		} else {
+			delete(ss.pressureExceeded, key)
+		}
+
+		log.Debugf(ss.ctx, "Container %s %s pressure %s threshold %v: avg10 %v",
+			c.ID(), threshold.Kind, threshold.Resource, threshold.Avg10, avg10)
+
+		ss.publishPressureEvent(&types.PressureEvent{
+			ContainerID:   c.ID(),
+			ContainerName: c.Name(),
+			PodSandboxID:  c.Sandbox(),
+			EventType:     eventType,
+			Resource:      threshold.Resource,
+			Kind:          threshold.Kind,
+			Avg10:         avg10,
+			Threshold:     threshold.Avg10,
+			CreatedAt:     time.Now().UnixNano(),
+		})
+	}
+}
+
+// pressureAvg10 returns the avg10 value of the pressure stall selected by the
+// threshold, if the cgroup reports it.
+func pressureAvg10(cgroupStats *stats.CgroupStats, threshold config.PressureThreshold) (float64, bool) {
+	var psi *cgroups.PSIStats
+
+	switch threshold.Resource {
+	case "cpu":
+		psi = cgroupStats.CpuStats.PSI
+	case "memory":
+		psi = cgroupStats.MemoryStats.PSI
+	case "io":
+		psi = cgroupStats.BlkioStats.PSI
+	}
+
+	if psi == nil {
+		return 0, false
+	}
+
+	if threshold.Kind == "full" {
+		return psi.Full.Avg10, true
+	}
+
+	return psi.Some.Avg10, true
+}
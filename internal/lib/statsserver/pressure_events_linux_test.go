@@ -0,0 +1,113 @@
+package statsserver
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/opencontainers/cgroups"
+	types "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	"github.com/cri-o/cri-o/internal/lib/stats"
+	"github.com/cri-o/cri-o/internal/oci"
+	"github.com/cri-o/cri-o/pkg/config"
+	pkgtypes "github.com/cri-o/cri-o/pkg/types"
+)
+
+// The actual test suite.
+var _ = t.Describe("PressureEvents", func() {
+	var (
+		sut    *StatsServer
+		ctr    *oci.Container
+		events <-chan *pkgtypes.PressureEvent
+		cancel func()
+	)
+
+	memoryStats := func(someAvg10, fullAvg10 float64) *stats.CgroupStats {
+		cgroupStats := &stats.CgroupStats{}
+		cgroupStats.MemoryStats.PSI = &cgroups.PSIStats{
+			Some: cgroups.PSIData{Avg10: someAvg10},
+			Full: cgroups.PSIData{Avg10: fullAvg10},
+		}
+
+		return cgroupStats
+	}
+
+	BeforeEach(func() {
+		var err error
+
+		ctr, err = oci.NewContainer("containerID", "containerName", "", "",
+			map[string]string{}, map[string]string{}, map[string]string{},
+			"image", nil, nil, "", &types.ContainerMetadata{}, "sandboxID",
+			false, false, false, "", "", time.Now(), "")
+		Expect(err).NotTo(HaveOccurred())
+
+		sut = &StatsServer{
+			pressureThresholds: []config.PressureThreshold{{Resource: "memory", Kind: "full", Avg10: 10}},
+			pressureExceeded:   map[pressureKey]bool{},
+		}
+		sut.pressureSubscribers.subscribers = map[chan *pkgtypes.PressureEvent]struct{}{}
+
+		events, cancel = sut.SubscribePressureEvents()
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("should publish an event when exceeding and dropping below a threshold", func() {
+		// Given
+		// When
+		sut.checkPressureThresholds(ctr, memoryStats(50, 20))
+
+		// Then
+		Expect(events).To(Receive(And(
+			HaveField("EventType", pkgtypes.PressureEventExceeded),
+			HaveField("ContainerID", "containerID"),
+			HaveField("PodSandboxID", "sandboxID"),
+			HaveField("Resource", "memory"),
+			HaveField("Kind", "full"),
+			HaveField("Avg10", 20.0),
+		)))
+
+		// When
+		sut.checkPressureThresholds(ctr, memoryStats(50, 5))
+
+		// Then
+		Expect(events).To(Receive(HaveField("EventType", pkgtypes.PressureEventRecovered)))
+	})
+
+	It("should publish a single event while the threshold stays exceeded", func() {
+		// Given
+		sut.checkPressureThresholds(ctr, memoryStats(0, 20))
+		Expect(events).To(Receive())
+
+		// When
+		sut.checkPressureThresholds(ctr, memoryStats(0, 30))
+
+		// Then
+		Expect(events).NotTo(Receive())
+	})
+
+	It("should skip containers without pressure stall information", func() {
+		// Given
+		// When
+		sut.checkPressureThresholds(ctr, &stats.CgroupStats{})
+
+		// Then
+		Expect(events).NotTo(Receive())
+	})
+
+	It("should forget the exceeded thresholds of removed containers", func() {
+		// Given
+		sut.checkPressureThresholds(ctr, memoryStats(0, 20))
+		Expect(events).To(Receive())
+
+		// When
+		sut.removePressureState(ctr.ID())
+		sut.checkPressureThresholds(ctr, memoryStats(0, 20))
+
+		// Then
+		Expect(events).To(Receive(HaveField("EventType", pkgtypes.PressureEventExceeded)))
+	})
+})
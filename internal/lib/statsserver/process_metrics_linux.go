@@ -70,5 +70,5 @@ func generateContainerProcessMetrics(ctr *oci.Container, pids *cgroups.PidsStats
 		},
 	}
 
-	return computeContainerMetrics(ctr, processMetrics, "process")
+	return computeContainerMetrics(ctr, processMetrics)
 }
@@ -0,0 +1,103 @@
+package statsserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	types "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	"github.com/cri-o/cri-o/internal/log"
+)
+
+// podLabelKeys are the labels added to every pod sandbox metric exposed to
+// Prometheus, because there is no kubelet to enrich them.
+var podLabelKeys = []string{"namespace", "pod", "container"}
+
+// PrometheusCollector exposes the pod sandbox metrics of the stats server as
+// a Prometheus collector.
+type PrometheusCollector struct {
+	ss          *StatsServer
+	descriptors map[string]*prometheus.Desc
+}
+
+// NewPrometheusCollector creates a new collector for the pod sandbox metrics
+// selected by the provided included pod metrics keys.
+func (ss *StatsServer) NewPrometheusCollector(includedKeys []string) *PrometheusCollector {
+	descriptors := map[string]*prometheus.Desc{}
+
+	for _, descs := range ss.PopulateMetricDescriptors(includedKeys) {
+		for _, d := range descs {
+			labelKeys := make([]string, 0, len(d.GetLabelKeys())+len(podLabelKeys))
+			labelKeys = append(labelKeys, d.GetLabelKeys()...)
+			labelKeys = append(labelKeys, podLabelKeys...)
+			descriptors[d.GetName()] = prometheus.NewDesc(d.GetName(), d.GetHelp(), labelKeys, nil)
+		}
+	}
+
+	return &PrometheusCollector{ss: ss, descriptors: descriptors}
+}
+
+// Describe implements prometheus.Collector.
+func (c *PrometheusCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range c.descriptors {
+		ch <- desc
+	}
+}
+
+// Collect implements prometheus.Collector.
+func (c *PrometheusCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx := context.Background()
+
+	for _, sm := range c.ss.MetricsForPodSandboxList(c.ss.ListSandboxes()) {
+		metrics := sm.GetMetric()
+
+		sb := c.ss.GetSandbox(metrics.GetPodSandboxId())
+		if sb == nil {
+			continue
+		}
+
+		namespace := sb.Metadata().GetNamespace()
+		pod := sb.Metadata().GetName()
+
+		for _, m := range metrics.GetMetrics() {
+			c.send(ctx, ch, m, namespace, pod, "")
+		}
+
+		containerNames := map[string]string{}
+		for _, ctr := range sb.Containers().List() {
+			containerNames[ctr.ID()] = ctr.Metadata().GetName()
+		}
+
+		for _, cm := range metrics.GetContainerMetrics() {
+			for _, m := range cm.GetMetrics() {
+				c.send(ctx, ch, m, namespace, pod, containerNames[cm.GetContainerId()])
+			}
+		}
+	}
+}
+
+func (c *PrometheusCollector) send(ctx context.Context, ch chan<- prometheus.Metric, m *types.Metric, podLabelValues ...string) {
+	desc, ok := c.descriptors[m.GetName()]
+	if !ok {
+		return
+	}
+
+	valueType := prometheus.GaugeValue
+	if m.GetMetricType() == types.MetricType_COUNTER {
+		valueType = prometheus.CounterValue
+	}
+
+	labelValues := make([]string, 0, len(m.GetLabelValues())+len(podLabelValues))
+	labelValues = append(labelValues, m.GetLabelValues()...)
+	labelValues = append(labelValues, podLabelValues...)
+
+	metric, err := prometheus.NewConstMetric(desc, valueType, float64(m.GetValue().GetValue()), labelValues...)
+	if err != nil {
+		log.Debugf(ctx, "Skipping pod metric %s: %v", m.GetName(), err)
+
+		return
+	}
+
+	ch <- prometheus.NewMetricWithTimestamp(time.Unix(0, m.GetTimestamp()), metric)
+}
@@ -0,0 +1,124 @@
+package statsserver
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	types "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+// The actual test suite.
+var _ = t.Describe("PrometheusCollector", func() {
+	var sut *PrometheusCollector
+
+	BeforeEach(func() {
+		sut = (&StatsServer{}).NewPrometheusCollector([]string{"network"})
+	})
+
+	collect := func(m *types.Metric, podLabelValues ...string) []prometheus.Metric {
+		ch := make(chan prometheus.Metric, 1)
+		sut.send(context.Background(), ch, m, podLabelValues...)
+		close(ch)
+
+		metrics := []prometheus.Metric{}
+		for metric := range ch {
+			metrics = append(metrics, metric)
+		}
+
+		return metrics
+	}
+
+	labels := func(m *dto.Metric) map[string]string {
+		res := map[string]string{}
+		for _, l := range m.GetLabel() {
+			res[l.GetName()] = l.GetValue()
+		}
+
+		return res
+	}
+
+	It("should describe the included metrics with the pod labels", func() {
+		// Given
+		ch := make(chan *prometheus.Desc, 100)
+
+		// When
+		sut.Describe(ch)
+		close(ch)
+
+		// Then
+		descs := []string{}
+		for desc := range ch {
+			descs = append(descs, desc.String())
+		}
+
+		Expect(descs).To(ContainElement(And(
+			ContainSubstring(`fqName: "container_network_receive_bytes_total"`),
+			ContainSubstring(`variableLabels: {id,name,image,interface,namespace,pod,container}`),
+		)))
+	})
+
+	It("should convert counters with the pod labels", func() {
+		// Given
+		m := &types.Metric{
+			Name:        containerNetworkReceiveBytesTotal.GetName(),
+			Timestamp:   time.Now().UnixNano(),
+			MetricType:  types.MetricType_COUNTER,
+			Value:       &types.UInt64Value{Value: 42},
+			LabelValues: []string{"id", "POD", "", "eth0"},
+		}
+
+		// When
+		metrics := collect(m, "namespace", "pod", "")
+
+		// Then
+		Expect(metrics).To(HaveLen(1))
+
+		res := &dto.Metric{}
+		Expect(metrics[0].Write(res)).To(Succeed())
+		Expect(res.GetCounter().GetValue()).To(BeEquivalentTo(42))
+		Expect(labels(res)).To(Equal(map[string]string{
+			"id":        "id",
+			"name":      "POD",
+			"image":     "",
+			"interface": "eth0",
+			"namespace": "namespace",
+			"pod":       "pod",
+			"container": "",
+		}))
+	})
+
+	It("should skip metrics which are not included", func() {
+		// Given
+		m := &types.Metric{
+			Name:       containerMemoryUsageBytes.GetName(),
+			MetricType: types.MetricType_GAUGE,
+			Value:      &types.UInt64Value{Value: 1},
+		}
+
+		// When
+		metrics := collect(m, "namespace", "pod", "")
+
+		// Then
+		Expect(metrics).To(BeEmpty())
+	})
+
+	It("should skip metrics with mismatching labels", func() {
+		// Given
+		m := &types.Metric{
+			Name:        containerNetworkReceiveBytesTotal.GetName(),
+			MetricType:  types.MetricType_COUNTER,
+			Value:       &types.UInt64Value{Value: 1},
+			LabelValues: []string{"id"},
+		}
+
+		// When
+		metrics := collect(m, "namespace", "pod", "")
+
+		// Then
+		Expect(metrics).To(BeEmpty())
+	})
+})
@@ -8,27 +8,37 @@ import (
 
 	"github.com/sirupsen/logrus"
 	cstorage "go.podman.io/storage"
+	"go.podman.io/storage/pkg/directory"
 	types "k8s.io/cri-api/pkg/apis/runtime/v1"
 
 	"github.com/cri-o/cri-o/internal/lib/sandbox"
 	"github.com/cri-o/cri-o/internal/oci"
 	"github.com/cri-o/cri-o/pkg/config"
+	pkgtypes "github.com/cri-o/cri-o/pkg/types"
 )
 
 // StatsServer is responsible for maintaining a list of container and sandbox stats.
-// If collectionPeriod is > 0, it maintains this list by updating the stats on collectionPeriod frequency.
-// Otherwise, it only updates the stats as they're requested.
+// If cacheTTL is > 0, it only updates the stats of the requested sandboxes and containers
+// whose cached entry expired or changed. If collectionPeriod is > 0, it maintains this list
+// by updating the stats on collectionPeriod frequency. Otherwise, it only updates the stats
+// as they're requested.
 type StatsServer struct {
 	parentServerIface
 
 	shutdown         chan struct{}
 	alreadyShutdown  bool
 	collectionPeriod time.Duration
+	cacheTTL         time.Duration
+	cache            map[cacheKey]cacheEntry
 	sboxStats        map[string]*types.PodSandboxStats
 	ctrStats         map[string]*types.ContainerStats
 	sboxMetrics      map[string]*SandboxMetrics
 	ctx              context.Context
 	mutex            sync.Mutex
+
+	pressureThresholds  []config.PressureThreshold
+	pressureExceeded    map[pressureKey]bool
+	pressureSubscribers pressureSubscribers
 }
 
 // parentServerIface is an interface for requesting information from the parent ContainerServer.
@@ -48,21 +58,27 @@ func New(ctx context.Context, cs parentServerIface) *StatsServer {
 		shutdown:          make(chan struct{}, 1),
 		alreadyShutdown:   false,
 		collectionPeriod:  time.Duration(cs.Config().CollectionPeriod) * time.Second,
+		cacheTTL:          time.Duration(cs.Config().StatsCacheTTL) * time.Second,
+		cache:             make(map[cacheKey]cacheEntry),
 		sboxStats:         make(map[string]*types.PodSandboxStats),
 		ctrStats:          make(map[string]*types.ContainerStats),
 		sboxMetrics:       make(map[string]*SandboxMetrics),
 		parentServerIface: cs,
 		ctx:               ctx,
 	}
+	ss.pressureThresholds = cs.Config().GetPressureThresholds()
+	ss.pressureExceeded = make(map[pressureKey]bool)
+	ss.pressureSubscribers.subscribers = make(map[chan *pkgtypes.PressureEvent]struct{})
+
 	go ss.updateLoop()
 
 	return ss
 }
 
 // updateLoop updates the current list of stats every collectionPeriod seconds.
-// If collectionPeriod is 0, it does nothing.
+// If collectionPeriod is 0 or a cache TTL is configured, it does nothing.
 func (ss *StatsServer) updateLoop() {
-	if ss.collectionPeriod == 0 {
+	if ss.collectionPeriod == 0 || ss.cacheTTL > 0 {
 		// fetch stats on-demand
 		return
 	}
@@ -125,25 +141,37 @@ func (ss *StatsServer) writableLayerForContainer(container *oci.Container) (*typ
 		FsId:      &types.FilesystemIdentifier{Mountpoint: container.MountPoint()},
 	}
 
+	usage, err := ss.writableLayerUsage(container)
+	if err != nil {
+		return writableLayer, err
+	}
+
+	writableLayer.UsedBytes = &types.UInt64Value{Value: uint64(usage.Size)}
+	writableLayer.InodesUsed = &types.UInt64Value{Value: uint64(usage.InodeCount)}
+
+	return writableLayer, nil
+}
+
+// writableLayerUsage returns the disk usage of the container's writable layer
+// as reported by the GraphDriver, which reads it from the project quota of the
+// layer if available and walks the layer otherwise.
+func (ss *StatsServer) writableLayerUsage(container *oci.Container) (*directory.DiskUsage, error) {
 	driver, err := ss.Store().GraphDriver()
 	if err != nil {
-		return writableLayer, fmt.Errorf("unable to get graph driver for disk usage for container %s: %w", container.ID(), err)
+		return nil, fmt.Errorf("unable to get graph driver for disk usage for container %s: %w", container.ID(), err)
 	}
 
 	storageContainer, err := ss.Store().Container(container.ID())
 	if err != nil {
-		return writableLayer, fmt.Errorf("unable to get storage container for disk usage for container %s: %w", container.ID(), err)
+		return nil, fmt.Errorf("unable to get storage container for disk usage for container %s: %w", container.ID(), err)
 	}
 
 	usage, err := driver.ReadWriteDiskUsage(storageContainer.LayerID)
 	if err != nil {
-		return writableLayer, fmt.Errorf("unable to get disk usage for container %s: %w", container.ID(), err)
+		return nil, fmt.Errorf("unable to get disk usage for container %s: %w", container.ID(), err)
 	}
 
-	writableLayer.UsedBytes = &types.UInt64Value{Value: uint64(usage.Size)}
-	writableLayer.InodesUsed = &types.UInt64Value{Value: uint64(usage.InodeCount)}
-
-	return writableLayer, nil
+	return usage, nil
 }
 
 // StatsForSandbox returns the stats for the given sandbox.
@@ -173,18 +201,23 @@ func (ss *StatsServer) StatsForSandboxes(sboxes []*sandbox.Sandbox) []*types.Pod
 // statsForSandbox is an internal, non-locking version of StatsForSandbox
 // that returns (and occasionally gathers) the stats for the given sandbox.
 func (ss *StatsServer) statsForSandbox(sb *sandbox.Sandbox) *types.PodSandboxStats {
-	if ss.collectionPeriod == 0 {
+	if ss.onDemand() {
 		return ss.updateSandbox(sb)
 	}
 
 	sboxStat, ok := ss.sboxStats[sb.ID()]
-	if ok {
+	if ss.cached(statsTypeSandboxStats, sb.ID(), ok, func() string { return sandboxFingerprint(sb) }) {
 		return sboxStat
 	}
 	// Cache miss, try again
 	return ss.updateSandbox(sb)
 }
 
+// onDemand returns true if the stats have to be collected on every request.
+func (ss *StatsServer) onDemand() bool {
+	return ss.collectionPeriod == 0 && ss.cacheTTL == 0
+}
+
 // RemoveStatsForSandbox removes the saved entry for the specified sandbox
 // to prevent the map from always growing.
 func (ss *StatsServer) RemoveStatsForSandbox(sb *sandbox.Sandbox) {
@@ -192,6 +225,7 @@ func (ss *StatsServer) RemoveStatsForSandbox(sb *sandbox.Sandbox) {
 	defer ss.mutex.Unlock()
 
 	delete(ss.sboxStats, sb.ID())
+	ss.uncache(sb.ID(), statsTypeSandboxStats)
 }
 
 // StatsForContainer returns the stats for the given container.
@@ -228,12 +262,12 @@ func (ss *StatsServer) StatsForContainers(ctrs []*oci.Container) []*types.Contai
 // statsForContainer is an internal, non-locking version of StatsForContainer
 // that returns (and occasionally gathers) the stats for the given container.
 func (ss *StatsServer) statsForContainer(c *oci.Container, sb *sandbox.Sandbox) *types.ContainerStats {
-	if ss.collectionPeriod == 0 {
+	if ss.onDemand() {
 		return ss.updateContainerStats(c, sb)
 	}
 
 	ctrStat, ok := ss.ctrStats[c.ID()]
-	if ok {
+	if ss.cached(statsTypeContainerStats, c.ID(), ok, func() string { return containerFingerprint(c) }) {
 		return ctrStat
 	}
 
@@ -247,6 +281,8 @@ func (ss *StatsServer) RemoveStatsForContainer(c *oci.Container) {
 	defer ss.mutex.Unlock()
 
 	delete(ss.ctrStats, c.ID())
+	ss.uncache(c.ID(), statsTypeContainerStats)
+	ss.removePressureState(c.ID())
 }
 
 // Shutdown tells the updateLoop to stop updating.
@@ -290,4 +326,5 @@ func (ss *StatsServer) RemoveMetricsForPodSandbox(sb *sandbox.Sandbox) {
 	defer ss.mutex.Unlock()
 
 	delete(ss.sboxMetrics, sb.ID())
+	ss.uncache(sb.ID(), statsTypeSandboxMetrics)
 }
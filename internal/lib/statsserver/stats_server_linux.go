@@ -20,6 +20,28 @@ import (
 	"github.com/cri-o/cri-o/pkg/config"
 )
 
+// diskStats returns the disk stats of the container. With the quota disk
+// usage method, the usage is the one of the writable layer, which the storage
+// driver reads from its project quota, or computes by walking the layer if no
+// project quota is available. Otherwise, the usage is the one of the whole
+// container file system, including the image layers.
+func (ss *StatsServer) diskStats(c *oci.Container, sb *sandbox.Sandbox) (*stats.DiskStats, error) {
+	if ss.Config().DiskUsageMethod == config.DiskUsageMethodQuota {
+		return ss.quotaDiskStats(c)
+	}
+
+	return ss.Runtime().DiskStats(ss.ctx, c, sb.CgroupParent())
+}
+
+func (ss *StatsServer) quotaDiskStats(c *oci.Container) (*stats.DiskStats, error) {
+	usage, err := ss.writableLayerUsage(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats.GetDiskStatsForUsage(c.MountPoint(), uint64(usage.Size))
+}
+
 // updateSandbox updates the StatsServer's entry for this sandbox, as well as each child container.
 // It first populates the stats from the CgroupParent, then calculates network usage, updates
 // each of its children container stats by calling into the runtime, and finally calculates the CPUNanoCores.
@@ -28,6 +50,9 @@ func (ss *StatsServer) updateSandbox(sb *sandbox.Sandbox) *types.PodSandboxStats
 		return nil
 	}
 
+	start := time.Now()
+	fingerprint := sandboxFingerprint(sb)
+
 	// Sandbox metrics are to fulfill the CRI metrics endpoint.
 	sandboxMetrics, exists := ss.sboxMetrics[sb.ID()]
 	if !exists {
@@ -77,7 +102,9 @@ func (ss *StatsServer) updateSandbox(sb *sandbox.Sandbox) *types.PodSandboxStats
 			log.Errorf(ss.ctx, "Error getting container stats %s: %v", c.ID(), err)
 		}
 
-		diskStats, err := ss.Runtime().DiskStats(ss.ctx, c, sb.CgroupParent())
+		ss.checkPressureThresholds(c, ctrStats)
+
+		diskStats, err := ss.diskStats(c, sb)
 		if err != nil {
 			log.Errorf(ss.ctx, "Error getting disk stats %s: %v", c.ID(), err)
 		}
@@ -106,6 +133,9 @@ func (ss *StatsServer) updateSandbox(sb *sandbox.Sandbox) *types.PodSandboxStats
 	ss.sboxStats[sb.ID()] = sandboxStats
 	ss.sboxMetrics[sb.ID()] = sandboxMetrics
 
+	ss.collected(statsTypeSandboxStats, sb.ID(), fingerprint, start)
+	ss.record(statsTypeSandboxMetrics, sb.ID(), fingerprint, start)
+
 	return sandboxStats
 }
 
@@ -121,6 +151,9 @@ func (ss *StatsServer) updateContainerStats(c *oci.Container, sb *sandbox.Sandbo
 		return nil
 	}
 
+	start := time.Now()
+	fingerprint := containerFingerprint(c)
+
 	ctrStats, err := ss.Runtime().ContainerStats(ss.ctx, c, sb.CgroupParent())
 	if err != nil {
 		log.Errorf(ss.ctx, "Error getting container stats %s: %v", c.ID(), err)
@@ -128,7 +161,9 @@ func (ss *StatsServer) updateContainerStats(c *oci.Container, sb *sandbox.Sandbo
 		return nil
 	}
 
-	diskStats, err := ss.Runtime().DiskStats(ss.ctx, c, sb.CgroupParent())
+	ss.checkPressureThresholds(c, ctrStats)
+
+	diskStats, err := ss.diskStats(c, sb)
 	if err != nil {
 		log.Errorf(ss.ctx, "Error getting disk stats %s: %v", c.ID(), err)
 		// Continue without disk stats
@@ -143,6 +178,7 @@ func (ss *StatsServer) updateContainerStats(c *oci.Container, sb *sandbox.Sandbo
 	}
 
 	ss.ctrStats[c.ID()] = cStats
+	ss.collected(statsTypeContainerStats, c.ID(), fingerprint, start)
 
 	return cStats
 }
@@ -184,11 +220,12 @@ func (ss *StatsServer) populateNetworkUsage(sbStats *types.PodSandboxStats, sb *
 // that returns (and occasionally gathers) the metrics for the given sandbox.
 // Note: caller must hold the lock on the StatsServer.
 func (ss *StatsServer) metricsForPodSandbox(sb *sandbox.Sandbox) *SandboxMetrics {
-	if ss.collectionPeriod == 0 {
+	if ss.onDemand() {
 		return ss.updatePodSandboxMetrics(sb)
 	}
 
-	if sboxMetrics, ok := ss.sboxMetrics[sb.ID()]; ok {
+	sboxMetrics, ok := ss.sboxMetrics[sb.ID()]
+	if ss.cached(statsTypeSandboxMetrics, sb.ID(), ok, func() string { return sandboxFingerprint(sb) }) {
 		return sboxMetrics
 	}
 	// Cache miss, try again.
@@ -203,6 +240,9 @@ func (ss *StatsServer) updatePodSandboxMetrics(sb *sandbox.Sandbox) *SandboxMetr
 		return nil
 	}
 
+	start := time.Now()
+	fingerprint := sandboxFingerprint(sb)
+
 	sm, exists := ss.sboxMetrics[sb.ID()]
 	if !exists {
 		sm = NewSandboxMetrics(sb)
@@ -228,6 +268,7 @@ func (ss *StatsServer) updatePodSandboxMetrics(sb *sandbox.Sandbox) *SandboxMetr
 
 	sm.metric.ContainerMetrics = containerMetrics
 	ss.sboxMetrics[sb.ID()] = sm
+	ss.collected(statsTypeSandboxMetrics, sb.ID(), fingerprint, start)
 
 	return sm
 }
@@ -243,7 +284,9 @@ func (ss *StatsServer) GenerateSandboxContainerMetrics(sb *sandbox.Sandbox, c *o
 		return nil
 	}
 
-	diskStats, err := ss.Runtime().DiskStats(ss.ctx, c, sb.CgroupParent())
+	ss.checkPressureThresholds(c, ctrStats)
+
+	diskStats, err := ss.diskStats(c, sb)
 	if err != nil {
 		log.Errorf(ss.ctx, "Error getting disk stats %s: %v", c.ID(), err)
 
@@ -262,7 +305,7 @@ func (ss *StatsServer) containerMetricsFromContainerStats(sb *sandbox.Sandbox, c
 				metricType: types.MetricType_GAUGE,
 			}}
 		},
-	}}, "")
+	}})
 
 	for _, m := range ss.Config().IncludedPodMetrics {
 		switch m {
@@ -0,0 +1,128 @@
+package statsserver
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cstorage "go.podman.io/storage"
+	graphdriver "go.podman.io/storage/drivers"
+	"go.podman.io/storage/drivers/vfs"
+	"go.uber.org/mock/gomock"
+
+	"github.com/cri-o/cri-o/internal/lib/sandbox"
+	"github.com/cri-o/cri-o/internal/oci"
+	"github.com/cri-o/cri-o/pkg/config"
+	containerstoragemock "github.com/cri-o/cri-o/test/mocks/containerstorage"
+)
+
+// fakeParentServer is a parentServerIface using the provided runtime, store
+// and configuration.
+type fakeParentServer struct {
+	runtime *oci.Runtime
+	store   cstorage.Store
+	config  *config.Config
+}
+
+func (f *fakeParentServer) Runtime() *oci.Runtime              { return f.runtime }
+func (f *fakeParentServer) Store() cstorage.Store              { return f.store }
+func (f *fakeParentServer) ListSandboxes() []*sandbox.Sandbox  { return nil }
+func (f *fakeParentServer) GetSandbox(string) *sandbox.Sandbox { return nil }
+func (f *fakeParentServer) Config() *config.Config             { return f.config }
+
+// The actual test suite.
+var _ = t.Describe("DiskStats", func() {
+	const (
+		containerID = "containerID"
+		layerID     = "layerID"
+	)
+
+	var (
+		sut       *StatsServer
+		storeMock *containerstoragemock.MockStore
+		ctr       *oci.Container
+		driver    graphdriver.Driver
+	)
+
+	writeFile := func(dir string, size int) {
+		Expect(os.WriteFile(filepath.Join(dir, "file"), make([]byte, size), 0o644)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		cfg, err := config.DefaultConfig()
+		Expect(err).NotTo(HaveOccurred())
+
+		runtime, err := oci.New(cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		mockCtrl := gomock.NewController(GinkgoT())
+		storeMock = containerstoragemock.NewMockStore(mockCtrl)
+
+		driver, err = vfs.Init(t.MustTempDir("graph"), graphdriver.Options{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(driver.CreateReadWrite(layerID, "", nil)).To(Succeed())
+
+		layerDir, err := driver.Get(layerID, graphdriver.MountOpts{})
+		Expect(err).NotTo(HaveOccurred())
+		writeFile(layerDir, 1024)
+
+		// The mount point includes the image layers besides the writable layer.
+		mountPoint := t.MustTempDir("rootfs")
+		writeFile(mountPoint, 4096)
+
+		ctr = oci.NewSpoofedContainer(containerID, "name", map[string]string{}, "sandboxID", time.Now(), "")
+		ctr.SetMountPoint(mountPoint)
+
+		sut = &StatsServer{
+			parentServerIface: &fakeParentServer{runtime: runtime, store: storeMock, config: cfg},
+			ctx:               context.Background(),
+		}
+	})
+
+	It("should walk the container file system with the walk method", func() {
+		// Given
+		sut.Config().DiskUsageMethod = config.DiskUsageMethodWalk
+
+		// When
+		res, err := sut.diskStats(ctr, newTestSandbox())
+
+		// Then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Filesystem.UsageBytes).To(BeNumerically(">=", 4096))
+		Expect(res.Filesystem.InodesTotal).NotTo(BeZero())
+	})
+
+	It("should report the writable layer usage with the quota method", func() {
+		// Given
+		sut.Config().DiskUsageMethod = config.DiskUsageMethodQuota
+		storeMock.EXPECT().GraphDriver().Return(driver, nil)
+		storeMock.EXPECT().Container(containerID).Return(&cstorage.Container{LayerID: layerID}, nil)
+
+		// When
+		res, err := sut.diskStats(ctr, newTestSandbox())
+
+		// Then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.Filesystem.UsageBytes).To(BeNumerically(">=", 1024))
+		Expect(res.Filesystem.UsageBytes).To(BeNumerically("<", 4096))
+		Expect(res.Filesystem.InodesTotal).NotTo(BeZero())
+	})
+
+	It("should fail with the quota method if the storage container is unavailable", func() {
+		// Given
+		sut.Config().DiskUsageMethod = config.DiskUsageMethodQuota
+		storeMock.EXPECT().GraphDriver().Return(driver, nil)
+		storeMock.EXPECT().Container(containerID).Return(nil, errors.New("not found"))
+
+		// When
+		res, err := sut.diskStats(ctr, newTestSandbox())
+
+		// Then
+		Expect(err).To(HaveOccurred())
+		Expect(res).To(BeNil())
+	})
+})
@@ -0,0 +1,62 @@
+package statsserver
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	types "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	"github.com/cri-o/cri-o/internal/hostport"
+	"github.com/cri-o/cri-o/internal/lib/sandbox"
+	"github.com/cri-o/cri-o/internal/memorystore"
+	"github.com/cri-o/cri-o/internal/oci"
+	. "github.com/cri-o/cri-o/test/framework"
+)
+
+// TestStatsServer runs the created specs.
+func TestStatsServer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunFrameworkSpecs(t, "StatsServer")
+}
+
+var t *TestFramework
+
+var _ = BeforeSuite(func() {
+	t = NewTestFramework(NilFunc, NilFunc)
+	t.Setup()
+})
+
+var _ = AfterSuite(func() {
+	t.Teardown()
+})
+
+func newTestSandbox() *sandbox.Sandbox {
+	sbox := sandbox.NewBuilder()
+	sbox.SetID("sandboxID")
+	sbox.SetName("sandboxName")
+	sbox.SetLogDir("test")
+	sbox.SetShmPath("test")
+	sbox.SetNamespace("")
+	sbox.SetKubeName("")
+	sbox.SetMountLabel("")
+	sbox.SetProcessLabel("")
+	sbox.SetCgroupParent("")
+	sbox.SetRuntimeHandler("")
+	sbox.SetResolvPath("")
+	sbox.SetHostname("")
+	sbox.SetPortMappings([]*hostport.PortMapping{})
+	sbox.SetHostNetwork(false)
+	sbox.SetUsernsMode("")
+	sbox.SetPodLinuxOverhead(nil)
+	sbox.SetPodLinuxResources(nil)
+	sbox.SetCreatedAt(time.Now())
+	Expect(sbox.SetCRISandbox(sbox.ID(), map[string]string{}, map[string]string{}, &types.PodSandboxMetadata{})).To(Succeed())
+	sbox.SetPrivileged(false)
+	sbox.SetContainers(memorystore.New[*oci.Container]())
+	sb, err := sbox.GetSandbox()
+	Expect(err).NotTo(HaveOccurred())
+
+	return sb
+}
@@ -51,7 +51,14 @@ func StreamInterceptor() grpc.StreamServerInterceptor {
 	}
 }
 
-func UnaryInterceptor() grpc.UnaryServerInterceptor {
+// OperationLabelsFunc returns the values of the optional operations metrics
+// labels for the provided request.
+type OperationLabelsFunc func(req any) metrics.OperationLabels
+
+// UnaryInterceptor returns the interceptor for logging, tracing and recording
+// the metrics of unary requests. The optional operationLabels func gets called
+// before handling the request if additional operations labels are enabled.
+func UnaryInterceptor(operationLabels OperationLabelsFunc) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req any,
@@ -64,15 +71,21 @@ func UnaryInterceptor() grpc.UnaryServerInterceptor {
 		newCtx, span := opentelemetry.Tracer().Start(AddRequestNameAndID(ctx, info.FullMethod), info.FullMethod)
 		log.Debugf(newCtx, "Request: %T: %+v", req, req)
 
+		// resolve the labels before the handler, which may remove the pod
+		var labels metrics.OperationLabels
+		if operationLabels != nil && metrics.Instance().OperationLabelsEnabled() {
+			labels = operationLabels(req)
+		}
+
 		resp, err := handler(newCtx, req)
 		// record the operation
-		metrics.Instance().MetricOperationsInc(operation)
-		metrics.Instance().MetricOperationsLatencySet(operation, operationStart)
-		metrics.Instance().MetricOperationsLatencyTotalObserve(operation, operationStart)
+		metrics.Instance().MetricOperationsInc(operation, labels)
+		metrics.Instance().MetricOperationsLatencySet(operation, labels, operationStart)
+		metrics.Instance().MetricOperationsLatencyTotalObserve(operation, labels, operationStart)
 
 		if err != nil {
 			log.Debugf(newCtx, "Response error: %+v", err)
-			metrics.Instance().MetricOperationsErrorsInc(operation)
+			metrics.Instance().MetricOperationsErrorsInc(operation, labels)
 		} else {
 			log.Debugf(newCtx, "Response: %T: %+v", resp, resp)
 		}
@@ -0,0 +1,239 @@
+// Package netteardown persists the pod networks whose teardown failed, so that
+// it can be retried in the background, even across restarts.
+package netteardown
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cri-o/ocicni/pkg/ocicni"
+	json "github.com/goccy/go-json"
+	"github.com/sirupsen/logrus"
+)
+
+// fileSuffix is the suffix of the files storing the pending teardowns.
+const fileSuffix = ".json"
+
+// MaxAttempts is the amount of retries after which a pending teardown is
+// dropped from the queue.
+const MaxAttempts = 60
+
+// Entry is a pod network whose teardown is pending.
+type Entry struct {
+	// Network is the pod network to be torn down.
+	Network ocicni.PodNetwork `json:"network"`
+
+	// Attempts is the amount of retries which failed so far.
+	Attempts int `json:"attempts"`
+
+	// QueuedAt is the time the teardown failed initially.
+	QueuedAt time.Time `json:"queued_at"`
+}
+
+// TearDownFunc tears down a pod network.
+type TearDownFunc func(context.Context, ocicni.PodNetwork) error
+
+// Queue is the persisted queue of pending pod network teardowns, one file per
+// pod sandbox in the queue directory.
+type Queue struct {
+	dir   string
+	mutex sync.Mutex
+}
+
+// New creates a new queue persisted in the provided directory, which is
+// created on demand.
+func New(dir string) *Queue {
+	return &Queue{dir: dir}
+}
+
+// Add queues the teardown of the pod network. Adding a pod network which is
+// already queued resets its entry.
+func (q *Queue) Add(network *ocicni.PodNetwork) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	return q.write(&Entry{Network: *network, QueuedAt: time.Now()})
+}
+
+// Remove removes the pending teardown of a pod sandbox, if any.
+func (q *Queue) Remove(id string) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	return q.remove(id)
+}
+
+// List returns all pending teardowns.
+func (q *Queue) List() ([]*Entry, error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	return q.list()
+}
+
+// Retry calls the teardown function for all pending teardowns. Successful
+// ones are removed from the queue, as well as the ones failed MaxAttempts
+// times. The teardowns run without holding the lock on the queue, and entries
+// which got added or removed concurrently are left untouched. It returns the
+// amount of pod networks remaining in the queue.
+func (q *Queue) Retry(ctx context.Context, tearDown TearDownFunc) (int, error) {
+	q.mutex.Lock()
+	entries, err := q.list()
+	q.mutex.Unlock()
+
+	if err != nil {
+		return 0, err
+	}
+
+	pending := 0
+
+	for _, entry := range entries {
+		if ctx.Err() != nil {
+			return pending, ctx.Err()
+		}
+
+		if q.update(entry, tearDown(ctx, entry.Network)) {
+			pending++
+		}
+	}
+
+	return pending, nil
+}
+
+// update records the result of retrying the teardown of the entry. It returns
+// true if the pod network remains in the queue.
+func (q *Queue) update(entry *Entry, tearDownErr error) bool {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	current, err := q.read(entry.Network.ID)
+	if err != nil {
+		logrus.Warnf("Unable to read pending network teardown of pod sandbox %s: %v", entry.Network.ID, err)
+
+		return true
+	}
+
+	if current == nil || current.Attempts != entry.Attempts || !current.QueuedAt.Equal(entry.QueuedAt) {
+		logrus.Debugf("Pending network teardown of pod sandbox %s changed during retry", entry.Network.ID)
+
+		return current != nil
+	}
+
+	if tearDownErr != nil {
+		entry.Attempts++
+
+		if entry.Attempts >= MaxAttempts {
+			logrus.Errorf("Giving up to tear down network of pod sandbox %s after %d attempts: %v", entry.Network.ID, entry.Attempts, tearDownErr)
+
+			if err := q.remove(entry.Network.ID); err != nil {
+				logrus.Warnf("Unable to remove pending network teardown of pod sandbox %s: %v", entry.Network.ID, err)
+			}
+
+			return false
+		}
+
+		logrus.Warnf("Unable to tear down network of pod sandbox %s (attempt %d): %v", entry.Network.ID, entry.Attempts, tearDownErr)
+
+		if err := q.write(entry); err != nil {
+			logrus.Warnf("Unable to update pending network teardown of pod sandbox %s: %v", entry.Network.ID, err)
+		}
+
+		return true
+	}
+
+	logrus.Infof("Successfully tore down pending network of pod sandbox %s", entry.Network.ID)
+
+	if err := q.remove(entry.Network.ID); err != nil {
+		logrus.Warnf("Unable to remove pending network teardown of pod sandbox %s: %v", entry.Network.ID, err)
+	}
+
+	return false
+}
+
+func (q *Queue) write(entry *Entry) error {
+	if err := os.MkdirAll(q.dir, 0o700); err != nil {
+		return fmt.Errorf("create network teardown queue directory: %w", err)
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshal pending network teardown: %w", err)
+	}
+
+	path := q.path(entry.Network.ID)
+
+	tmpPath := filepath.Join(q.dir, "."+filepath.Base(path))
+	if err := os.WriteFile(tmpPath, data, 0o600); err != nil {
+		return fmt.Errorf("write pending network teardown: %w", err)
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+func (q *Queue) remove(id string) error {
+	if err := os.Remove(q.path(id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove pending network teardown: %w", err)
+	}
+
+	return nil
+}
+
+func (q *Queue) list() ([]*Entry, error) {
+	dirEntries, err := os.ReadDir(q.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("read network teardown queue directory: %w", err)
+	}
+
+	res := []*Entry{}
+
+	for _, dirEntry := range dirEntries {
+		if dirEntry.IsDir() || strings.HasPrefix(dirEntry.Name(), ".") || !strings.HasSuffix(dirEntry.Name(), fileSuffix) {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(q.dir, dirEntry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("read pending network teardown: %w", err)
+		}
+
+		entry := &Entry{}
+		if err := json.Unmarshal(data, entry); err != nil {
+			logrus.Warnf("Ignoring invalid pending network teardown %s: %v", dirEntry.Name(), err)
+
+			continue
+		}
+
+		res = append(res, entry)
+	}
+
+	return res, nil
+}
+
+// read returns the pending teardown of a pod sandbox, or nil if there is none.
+func (q *Queue) read(id string) (*Entry, error) {
+	data, err := os.ReadFile(q.path(id))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("read pending network teardown: %w", err)
+	}
+
+	entry := &Entry{}
+	if err := json.Unmarshal(data, entry); err != nil {
+		return nil, fmt.Errorf("unmarshal pending network teardown: %w", err)
+	}
+
+	return entry, nil
+}
+
+func (q *Queue) path(id string) string {
+	return filepath.Join(q.dir, id+fileSuffix)
+}
@@ -0,0 +1,152 @@
+package netteardown_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+
+	"github.com/cri-o/ocicni/pkg/ocicni"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cri-o/cri-o/internal/netteardown"
+)
+
+var _ = t.Describe("Queue", func() {
+	var (
+		sut *netteardown.Queue
+		dir string
+	)
+
+	BeforeEach(func() {
+		dir = filepath.Join(t.MustTempDir("netteardown"), "queue")
+		sut = netteardown.New(dir)
+	})
+
+	It("should be empty if the directory does not exist", func() {
+		// When
+		entries, err := sut.List()
+
+		// Then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(BeEmpty())
+	})
+
+	It("should add and remove pod networks", func() {
+		// Given
+		Expect(sut.Add(&ocicni.PodNetwork{ID: "pod1", Name: "name1"})).To(Succeed())
+		Expect(sut.Add(&ocicni.PodNetwork{ID: "pod2", Name: "name2"})).To(Succeed())
+
+		// When
+		err := sut.Remove("pod1")
+
+		// Then
+		Expect(err).NotTo(HaveOccurred())
+		entries, err := sut.List()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].Network.ID).To(Equal("pod2"))
+		Expect(entries[0].Network.Name).To(Equal("name2"))
+		Expect(entries[0].Attempts).To(BeZero())
+	})
+
+	It("should persist the pod networks", func() {
+		// Given
+		Expect(sut.Add(&ocicni.PodNetwork{ID: "pod1"})).To(Succeed())
+
+		// When
+		entries, err := netteardown.New(dir).List()
+
+		// Then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+	})
+
+	It("should remove the pod networks torn down on retry", func() {
+		// Given
+		Expect(sut.Add(&ocicni.PodNetwork{ID: "pod1"})).To(Succeed())
+		Expect(sut.Add(&ocicni.PodNetwork{ID: "pod2"})).To(Succeed())
+
+		// When
+		pending, err := sut.Retry(context.Background(), func(_ context.Context, network ocicni.PodNetwork) error {
+			if network.ID == "pod1" {
+				return errors.New("teardown failed")
+			}
+
+			return nil
+		})
+
+		// Then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pending).To(Equal(1))
+		entries, err := sut.List()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].Network.ID).To(Equal("pod1"))
+		Expect(entries[0].Attempts).To(Equal(1))
+	})
+
+	It("should give up after the maximum attempts", func() {
+		// Given
+		Expect(sut.Add(&ocicni.PodNetwork{ID: "pod1"})).To(Succeed())
+
+		failingTearDown := func(context.Context, ocicni.PodNetwork) error {
+			return errors.New("teardown failed")
+		}
+
+		for range netteardown.MaxAttempts - 1 {
+			_, err := sut.Retry(context.Background(), failingTearDown)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		// When
+		pending, err := sut.Retry(context.Background(), failingTearDown)
+
+		// Then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pending).To(BeZero())
+		entries, err := sut.List()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(BeEmpty())
+	})
+
+	It("should not restore pod networks removed during retry", func() {
+		// Given
+		Expect(sut.Add(&ocicni.PodNetwork{ID: "pod1"})).To(Succeed())
+
+		// When
+		pending, err := sut.Retry(context.Background(), func(_ context.Context, network ocicni.PodNetwork) error {
+			Expect(sut.Remove(network.ID)).To(Succeed())
+
+			return errors.New("teardown failed")
+		})
+
+		// Then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pending).To(BeZero())
+		entries, err := sut.List()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(BeEmpty())
+	})
+
+	It("should keep pod networks added again during retry", func() {
+		// Given
+		Expect(sut.Add(&ocicni.PodNetwork{ID: "pod1", Name: "name1"})).To(Succeed())
+
+		// When
+		pending, err := sut.Retry(context.Background(), func(_ context.Context, network ocicni.PodNetwork) error {
+			Expect(sut.Add(&ocicni.PodNetwork{ID: network.ID, Name: "name2"})).To(Succeed())
+
+			return nil
+		})
+
+		// Then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pending).To(Equal(1))
+		entries, err := sut.List()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].Network.Name).To(Equal("name2"))
+		Expect(entries[0].Attempts).To(BeZero())
+	})
+})
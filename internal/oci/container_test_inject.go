@@ -6,6 +6,13 @@
 package oci
 
 import (
+	"context"
+	"io"
+	"time"
+
+	conmonClient "github.com/containers/conmon-rs/pkg/client"
+	types "k8s.io/cri-api/pkg/apis/runtime/v1"
+
 	"github.com/cri-o/cri-o/pkg/config"
 )
 
@@ -42,3 +49,69 @@ func NewRuntimeOCI(r *Runtime, handler *config.RuntimeHandler) RuntimeOCI {
 		},
 	}
 }
+
+// CopyDatagramsToContainer exports copyDatagramsToContainer for testing.
+func CopyDatagramsToContainer(conn io.Writer, stream io.Reader) error {
+	return copyDatagramsToContainer(conn, stream)
+}
+
+// CopyDatagramsFromContainer exports copyDatagramsFromContainer for testing.
+func CopyDatagramsFromContainer(stream io.Writer, conn io.Reader) error {
+	return copyDatagramsFromContainer(stream, conn)
+}
+
+type RuntimePod struct {
+	*runtimePod
+}
+
+// NewRuntimePod creates a pod runtime without a conmon-rs client.
+func NewRuntimePod(r *Runtime, handler *config.RuntimeHandler) RuntimePod {
+	return RuntimePod{
+		runtimePod: &runtimePod{
+			oci: &runtimeOCI{
+				Runtime: r,
+				root:    handler.RuntimeRoot,
+				handler: handler,
+			},
+		},
+	}
+}
+
+// TruncateExecSyncResponse exports truncateExecSyncResponse for testing.
+func TruncateExecSyncResponse(c *Container, handler *config.RuntimeHandler, resp *types.ExecSyncResponse, truncated bool) {
+	truncateExecSyncResponse(context.Background(), c, handler, resp, truncated)
+}
+
+// LogDriverConmonArgs returns the conmon arguments of all log drivers of the
+// runtime handler.
+func LogDriverConmonArgs(c *Container, handler *config.RuntimeHandler, logToJournald, supportsLogLabel bool) []string {
+	args := []string{}
+	for _, driver := range logDrivers(handler, logToJournald) {
+		args = append(args, driver.conmonArgs(c, func() bool { return supportsLogLabel })...)
+	}
+
+	return args
+}
+
+// LogDriverConmonrsDrivers returns the conmon-rs configuration of all log
+// drivers of the runtime handler.
+func LogDriverConmonrsDrivers(c *Container, handler *config.RuntimeHandler, maxSize uint64) []conmonClient.ContainerLogDriver {
+	drivers := []conmonClient.ContainerLogDriver{}
+	for _, driver := range logDrivers(handler, false) {
+		drivers = append(drivers, driver.conmonrsLogDriver(c, maxSize))
+	}
+
+	return drivers
+}
+
+// WatchPortForwardIdle watches a new port forward session for the idle
+// timeout and returns whether it got terminated as idle.
+func WatchPortForwardIdle(ctx context.Context, timeout time.Duration) bool {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	s := newPortForwardSession(types.Protocol_UDP)
+	s.watchIdle(ctx, timeout, cancel)
+
+	return s.idle.Load()
+}
@@ -0,0 +1,130 @@
+package oci
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+
+	conmonClient "github.com/containers/conmon-rs/pkg/client"
+	"github.com/sirupsen/logrus"
+	kubeletTypes "k8s.io/kubelet/pkg/types"
+
+	"github.com/cri-o/cri-o/pkg/config"
+)
+
+// logDriver is a destination of the container output.
+type logDriver interface {
+	// conmonArgs returns the arguments to enable the driver in conmon, where
+	// supportsLogLabel reports whether conmon supports the --log-label
+	// option.
+	conmonArgs(c *Container, supportsLogLabel func() bool) []string
+
+	// conmonrsLogDriver returns the driver configuration for conmon-rs.
+	conmonrsLogDriver(c *Container, maxSize uint64) conmonClient.ContainerLogDriver
+}
+
+// kubernetesLogDriver writes the kubernetes container log file in the CRI
+// format read by the kubelet.
+type kubernetesLogDriver struct{}
+
+func (d *kubernetesLogDriver) conmonArgs(c *Container, _ func() bool) []string {
+	return []string{"-l", c.logPath}
+}
+
+func (d *kubernetesLogDriver) conmonrsLogDriver(c *Container, maxSize uint64) conmonClient.ContainerLogDriver {
+	return conmonClient.ContainerLogDriver{
+		Type:    conmonClient.LogDriverTypeContainerRuntimeInterface,
+		Path:    c.logPath,
+		MaxSize: maxSize,
+	}
+}
+
+// jsonLogDriver writes the container output in the JSON lines format next to
+// the kubernetes container log file.
+type jsonLogDriver struct{}
+
+// jsonLogPath returns the path of the JSON lines log file of the container.
+// The kubelet treats files starting with the kubernetes container log path as
+// rotated logs, which is why the extension gets replaced instead of appending
+// to it.
+func jsonLogPath(c *Container) string {
+	return strings.TrimSuffix(c.logPath, filepath.Ext(c.logPath)) + ".json"
+}
+
+func (d *jsonLogDriver) conmonArgs(*Container, func() bool) []string {
+	// conmon does not support the JSON lines format, which is ensured by the
+	// runtime handler validation.
+	return nil
+}
+
+func (d *jsonLogDriver) conmonrsLogDriver(c *Container, maxSize uint64) conmonClient.ContainerLogDriver {
+	return conmonClient.ContainerLogDriver{
+		Type:    conmonClient.LogDriverTypeJSONLogger,
+		Path:    jsonLogPath(c),
+		MaxSize: maxSize,
+	}
+}
+
+// journaldLogDriver forwards the container output to the systemd journal.
+type journaldLogDriver struct {
+	// withLabels adds the pod and container fields to the journal entries.
+	withLabels bool
+}
+
+// journaldLogLabels maps the journal fields to the container labels they are
+// populated from.
+var journaldLogLabels = []struct {
+	field, label string
+}{
+	{"K8S_POD_NAME", kubeletTypes.KubernetesPodNameLabel},
+	{"K8S_POD_NAMESPACE", kubeletTypes.KubernetesPodNamespaceLabel},
+	{"K8S_POD_UID", kubeletTypes.KubernetesPodUIDLabel},
+	{"K8S_CONTAINER_NAME", kubeletTypes.KubernetesContainerNameLabel},
+}
+
+func (d *journaldLogDriver) conmonArgs(c *Container, supportsLogLabel func() bool) []string {
+	args := []string{"--log-path", "journald:"}
+	if !d.withLabels {
+		return args
+	}
+
+	if !supportsLogLabel() {
+		logrus.Debugf("Not adding pod and container fields to the journal entries of container %s: conmon does not support --log-label", c.ID())
+
+		return args
+	}
+
+	labels := c.Labels()
+	for _, l := range journaldLogLabels {
+		if value := labels[l.label]; value != "" {
+			args = append(args, "--log-label", l.field+"="+value)
+		}
+	}
+
+	return args
+}
+
+func (d *journaldLogDriver) conmonrsLogDriver(*Container, uint64) conmonClient.ContainerLogDriver {
+	// conmon-rs populates the container fields on its own.
+	return conmonClient.ContainerLogDriver{Type: conmonClient.LogDriverTypeJournald}
+}
+
+// logDrivers returns all log drivers configured for the runtime handler. The
+// kubernetes log file driver always comes first.
+func logDrivers(handler *config.RuntimeHandler, logToJournald bool) []logDriver {
+	drivers := []logDriver{&kubernetesLogDriver{}}
+
+	// The global log_to_journald option does not pass any additional fields
+	// to keep its existing journal entries unchanged.
+	if slices.Contains(handler.ContainerLogDrivers, config.ContainerLogDriverJournald) {
+		drivers = append(drivers, &journaldLogDriver{withLabels: true})
+	} else if logToJournald {
+		drivers = append(drivers, &journaldLogDriver{})
+	}
+
+	if slices.Contains(handler.ContainerLogDrivers, config.ContainerLogDriverJSON) {
+		drivers = append(drivers, &jsonLogDriver{})
+	}
+
+	return drivers
+}
@@ -0,0 +1,132 @@
+package oci_test
+
+import (
+	"time"
+
+	conmonClient "github.com/containers/conmon-rs/pkg/client"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	types "k8s.io/cri-api/pkg/apis/runtime/v1"
+	kubeletTypes "k8s.io/kubelet/pkg/types"
+
+	"github.com/cri-o/cri-o/internal/oci"
+	libconfig "github.com/cri-o/cri-o/pkg/config"
+)
+
+// The actual test suite.
+var _ = t.Describe("LogDriver", func() {
+	var ctr *oci.Container
+
+	BeforeEach(func() {
+		var err error
+		ctr, err = oci.NewContainer("id", "name", "bundlePath", "/var/log/pods/pod/container/0.log",
+			map[string]string{
+				kubeletTypes.KubernetesPodNameLabel:       "pod",
+				kubeletTypes.KubernetesPodNamespaceLabel:  "namespace",
+				kubeletTypes.KubernetesPodUIDLabel:        "uid",
+				kubeletTypes.KubernetesContainerNameLabel: "container",
+			},
+			nil, nil, "image", nil, nil, "", &types.ContainerMetadata{}, "sandbox",
+			false, false, false, "", "dir", time.Now(), "")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	t.Describe("conmon arguments", func() {
+		It("should only write the kubernetes log file by default", func() {
+			// Given
+			handler := &libconfig.RuntimeHandler{}
+
+			// When
+			args := oci.LogDriverConmonArgs(ctr, handler, false, true)
+
+			// Then
+			Expect(args).To(Equal([]string{"-l", "/var/log/pods/pod/container/0.log"}))
+		})
+
+		It("should forward to journald without fields for log_to_journald", func() {
+			// Given
+			handler := &libconfig.RuntimeHandler{}
+
+			// When
+			args := oci.LogDriverConmonArgs(ctr, handler, true, true)
+
+			// Then
+			Expect(args).To(Equal([]string{"-l", "/var/log/pods/pod/container/0.log", "--log-path", "journald:"}))
+		})
+
+		It("should add the journal fields for the journald driver", func() {
+			// Given
+			handler := &libconfig.RuntimeHandler{
+				ContainerLogDrivers: []string{libconfig.ContainerLogDriverJournald},
+			}
+
+			// When
+			args := oci.LogDriverConmonArgs(ctr, handler, true, true)
+
+			// Then
+			Expect(args).To(Equal([]string{
+				"-l", "/var/log/pods/pod/container/0.log",
+				"--log-path", "journald:",
+				"--log-label", "K8S_POD_NAME=pod",
+				"--log-label", "K8S_POD_NAMESPACE=namespace",
+				"--log-label", "K8S_POD_UID=uid",
+				"--log-label", "K8S_CONTAINER_NAME=container",
+			}))
+		})
+
+		It("should not add the journal fields if conmon does not support them", func() {
+			// Given
+			handler := &libconfig.RuntimeHandler{
+				ContainerLogDrivers: []string{libconfig.ContainerLogDriverJournald},
+			}
+
+			// When
+			args := oci.LogDriverConmonArgs(ctr, handler, false, false)
+
+			// Then
+			Expect(args).To(Equal([]string{"-l", "/var/log/pods/pod/container/0.log", "--log-path", "journald:"}))
+		})
+	})
+
+	t.Describe("conmon-rs drivers", func() {
+		It("should use the CRI logger by default", func() {
+			// Given
+			handler := &libconfig.RuntimeHandler{}
+
+			// When
+			drivers := oci.LogDriverConmonrsDrivers(ctr, handler, 1024)
+
+			// Then
+			Expect(drivers).To(Equal([]conmonClient.ContainerLogDriver{{
+				Type:    conmonClient.LogDriverTypeContainerRuntimeInterface,
+				Path:    "/var/log/pods/pod/container/0.log",
+				MaxSize: 1024,
+			}}))
+		})
+
+		It("should add the JSON logger next to the CRI logger", func() {
+			// Given
+			handler := &libconfig.RuntimeHandler{
+				ContainerLogDrivers: []string{libconfig.ContainerLogDriverJSON, libconfig.ContainerLogDriverJournald},
+			}
+
+			// When
+			drivers := oci.LogDriverConmonrsDrivers(ctr, handler, 1024)
+
+			// Then
+			Expect(drivers).To(Equal([]conmonClient.ContainerLogDriver{
+				{
+					Type:    conmonClient.LogDriverTypeContainerRuntimeInterface,
+					Path:    "/var/log/pods/pod/container/0.log",
+					MaxSize: 1024,
+				},
+				{Type: conmonClient.LogDriverTypeJournald},
+				{
+					Type:    conmonClient.LogDriverTypeJSONLogger,
+					Path:    "/var/log/pods/pod/container/0.json",
+					MaxSize: 1024,
+				},
+			}))
+		})
+	})
+})
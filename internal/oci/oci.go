@@ -13,13 +13,16 @@ import (
 
 	"github.com/docker/go-units"
 	rspec "github.com/opencontainers/runtime-spec/specs-go"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/client-go/tools/remotecommand"
 	types "k8s.io/cri-api/pkg/apis/runtime/v1"
 
 	"github.com/cri-o/cri-o/internal/config/seccomp"
 	"github.com/cri-o/cri-o/internal/lib/stats"
 	"github.com/cri-o/cri-o/internal/log"
+	"github.com/cri-o/cri-o/internal/opentelemetry"
 	"github.com/cri-o/cri-o/pkg/config"
+	"github.com/cri-o/cri-o/server/metrics"
 )
 
 const (
@@ -36,10 +39,10 @@ const (
 	// be SIGKILLed.
 	killContainerTimeout = 2 * time.Minute
 
-	// maxExecSyncSize is the maximum size of exec sync output CRI-O will process.
-	// It is set to the amount of logs allowed in the dockershim implementation:
-	// https://github.com/kubernetes/kubernetes/pull/82514
-	maxExecSyncSize = 16 * 1024 * 1024
+	// ExecSyncTruncatedMessage is appended to the stdout or stderr of an exec
+	// sync response if the output exceeded the configured maximum size. It is
+	// part of the response payload and not counted against that size.
+	ExecSyncTruncatedMessage = "\n[output truncated by CRI-O: exceeded %d bytes]\n"
 )
 
 // Runtime is the generic structure holding both global and specific
@@ -74,7 +77,7 @@ type RuntimeImpl interface {
 	AttachContainer(context.Context, *Container, io.Reader, io.WriteCloser, io.WriteCloser,
 		bool, <-chan remotecommand.TerminalSize) error
 	PortForwardContainer(context.Context, *Container, string,
-		int32, io.ReadWriteCloser) error
+		int32, types.Protocol, io.ReadWriteCloser) error
 	ReopenContainerLog(context.Context, *Container) error
 	CheckpointContainer(context.Context, *Container, *rspec.Spec, bool) error
 	RestoreContainer(context.Context, *Container, string, string) error
@@ -285,6 +288,34 @@ func (r *Runtime) newRuntimeImpl(c *Container) (RuntimeImpl, error) {
 	return newRuntimeOCI(r, rh), nil
 }
 
+// setSpanAttributes adds the container and runtime attributes to the provided
+// span.
+func (r *Runtime) setSpanAttributes(span trace.Span, c *Container) {
+	span.SetAttributes(
+		opentelemetry.ContainerIDKey.String(c.ID()),
+		opentelemetry.PodIDKey.String(c.Sandbox()),
+	)
+
+	handler := c.runtimeHandler
+	if handler == "" {
+		handler = r.config.DefaultRuntime
+	}
+
+	span.SetAttributes(opentelemetry.RuntimeHandlerKey.String(handler))
+
+	if rh, err := r.getRuntimeHandler(c.runtimeHandler); err == nil && rh != nil {
+		span.SetAttributes(opentelemetry.RuntimePathKey.String(rh.RuntimePath))
+	}
+}
+
+// setSpanExitCode adds the exit code of the container to the provided span,
+// if available.
+func setSpanExitCode(span trace.Span, c *Container) {
+	if state := c.State(); state != nil && state.ExitCode != nil {
+		span.SetAttributes(opentelemetry.ExitCodeKey.Int(int(*state.ExitCode)))
+	}
+}
+
 // RuntimeImpl returns the runtime implementation for a given container.
 func (r *Runtime) RuntimeImpl(c *Container) (RuntimeImpl, error) {
 	r.runtimeImplMapMutex.RLock()
@@ -312,6 +343,8 @@ func (r *Runtime) RuntimeImpl(c *Container) (RuntimeImpl, error) {
 func (r *Runtime) CreateContainer(ctx context.Context, c *Container, cgroupParent string, restore bool) error {
 	ctx, span := log.StartSpan(ctx)
 	defer span.End()
+
+	r.setSpanAttributes(span, c)
 	// Instantiate a new runtime implementation for this new container
 	impl, err := r.newRuntimeImpl(c)
 	if err != nil {
@@ -331,6 +364,8 @@ func (r *Runtime) StartContainer(ctx context.Context, c *Container) error {
 	ctx, span := log.StartSpan(ctx)
 	defer span.End()
 
+	r.setSpanAttributes(span, c)
+
 	impl, err := r.RuntimeImpl(c)
 	if err != nil {
 		return err
@@ -357,12 +392,77 @@ func (r *Runtime) ExecSyncContainer(ctx context.Context, c *Container, command [
 	ctx, span := log.StartSpan(ctx)
 	defer span.End()
 
+	r.setSpanAttributes(span, c)
+
 	impl, err := r.RuntimeImpl(c)
 	if err != nil {
 		return nil, err
 	}
 
-	return impl.ExecSyncContainer(ctx, c, command, timeout)
+	handler := c.runtimeHandler
+	if handler == "" {
+		handler = r.config.DefaultRuntime
+	}
+
+	defer metrics.Instance().MetricContainersExecSyncLatencyObserve(handler, time.Now())
+
+	resp, err := impl.ExecSyncContainer(ctx, c, command, timeout)
+	if resp != nil {
+		span.SetAttributes(opentelemetry.ExitCodeKey.Int(int(resp.GetExitCode())))
+	}
+
+	return resp, err
+}
+
+// execSyncMaxOutputSize returns the maximum combined size of the decoded
+// stdout and stderr of an exec sync request for the provided runtime handler.
+func execSyncMaxOutputSize(handler *config.RuntimeHandler) int64 {
+	if handler == nil || handler.ExecSyncMaxOutputSize <= 0 {
+		return config.DefaultExecSyncMaxOutputSize
+	}
+
+	return handler.ExecSyncMaxOutputSize
+}
+
+// truncateExecSyncOutput limits the provided output to maxSize bytes. If the
+// output exceeds the limit, then the ExecSyncTruncatedMessage gets appended
+// and true is returned.
+func truncateExecSyncOutput(output []byte, maxSize, limit int64) ([]byte, bool) {
+	if int64(len(output)) <= maxSize {
+		return output, false
+	}
+
+	return append(output[:maxSize:maxSize], fmt.Sprintf(ExecSyncTruncatedMessage, limit)...), true
+}
+
+// truncateExecSyncResponse applies the maximum exec sync output size of the
+// runtime handler to the combined stdout and stderr of the provided response,
+// where stdout takes precedence. The truncated argument can be used to
+// indicate that the output has been already truncated by the runtime
+// implementation, which ensures that the response contains the
+// ExecSyncTruncatedMessage.
+func truncateExecSyncResponse(ctx context.Context, c *Container, handler *config.RuntimeHandler, resp *types.ExecSyncResponse, truncated bool) {
+	if resp == nil {
+		return
+	}
+
+	maxSize := execSyncMaxOutputSize(handler)
+
+	var stdoutTruncated, stderrTruncated bool
+
+	resp.Stdout, stdoutTruncated = truncateExecSyncOutput(resp.Stdout, maxSize, maxSize)
+	remaining := maxSize - min(int64(len(resp.Stdout)), maxSize)
+	resp.Stderr, stderrTruncated = truncateExecSyncOutput(resp.Stderr, remaining, maxSize)
+
+	if truncated && !stdoutTruncated && !stderrTruncated {
+		resp.Stdout = append(resp.Stdout, fmt.Sprintf(ExecSyncTruncatedMessage, maxSize)...)
+		stdoutTruncated = true
+	}
+
+	if stdoutTruncated || stderrTruncated {
+		log.Warnf(ctx, "Truncated exec sync output of container %s to %d bytes", c.ID(), maxSize)
+		metrics.Instance().MetricContainersExecSyncTruncatedInc()
+	}
 }
 
 // UpdateContainer updates container resources.
@@ -370,6 +470,8 @@ func (r *Runtime) UpdateContainer(ctx context.Context, c *Container, res *rspec.
 	ctx, span := log.StartSpan(ctx)
 	defer span.End()
 
+	r.setSpanAttributes(span, c)
+
 	impl, err := r.RuntimeImpl(c)
 	if err != nil {
 		return err
@@ -383,6 +485,9 @@ func (r *Runtime) StopContainer(ctx context.Context, c *Container, timeout int64
 	ctx, span := log.StartSpan(ctx)
 	defer span.End()
 
+	r.setSpanAttributes(span, c)
+	defer setSpanExitCode(span, c)
+
 	impl, err := r.RuntimeImpl(c)
 	if err != nil {
 		return err
@@ -396,6 +501,8 @@ func (r *Runtime) DeleteContainer(ctx context.Context, c *Container) (err error)
 	ctx, span := log.StartSpan(ctx)
 	defer span.End()
 
+	r.setSpanAttributes(span, c)
+
 	r.runtimeImplMapMutex.RLock()
 	impl, ok := r.runtimeImplMap[c.ID()]
 	r.runtimeImplMapMutex.RUnlock()
@@ -422,6 +529,9 @@ func (r *Runtime) UpdateContainerStatus(ctx context.Context, c *Container) error
 	ctx, span := log.StartSpan(ctx)
 	defer span.End()
 
+	r.setSpanAttributes(span, c)
+	defer setSpanExitCode(span, c)
+
 	impl, err := r.RuntimeImpl(c)
 	if err != nil {
 		return err
@@ -435,6 +545,8 @@ func (r *Runtime) PauseContainer(ctx context.Context, c *Container) error {
 	ctx, span := log.StartSpan(ctx)
 	defer span.End()
 
+	r.setSpanAttributes(span, c)
+
 	impl, err := r.RuntimeImpl(c)
 	if err != nil {
 		return err
@@ -448,6 +560,8 @@ func (r *Runtime) UnpauseContainer(ctx context.Context, c *Container) error {
 	ctx, span := log.StartSpan(ctx)
 	defer span.End()
 
+	r.setSpanAttributes(span, c)
+
 	impl, err := r.RuntimeImpl(c)
 	if err != nil {
 		return err
@@ -496,7 +610,7 @@ func (r *Runtime) AttachContainer(ctx context.Context, c *Container, inputStream
 }
 
 // PortForwardContainer forwards the specified port provides statistics of a container.
-func (r *Runtime) PortForwardContainer(ctx context.Context, c *Container, netNsPath string, port int32, stream io.ReadWriteCloser) error {
+func (r *Runtime) PortForwardContainer(ctx context.Context, c *Container, netNsPath string, port int32, protocol types.Protocol, stream io.ReadWriteCloser) error {
 	ctx, span := log.StartSpan(ctx)
 	defer span.End()
 
@@ -505,7 +619,7 @@ func (r *Runtime) PortForwardContainer(ctx context.Context, c *Container, netNsP
 		return err
 	}
 
-	return impl.PortForwardContainer(ctx, c, netNsPath, port, stream)
+	return impl.PortForwardContainer(ctx, c, netNsPath, port, protocol, stream)
 }
 
 // ReopenContainerLog reopens the log file of a container.
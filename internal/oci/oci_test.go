@@ -2,12 +2,14 @@ package oci_test
 
 import (
 	"context"
+	"fmt"
 	"os"
 
 	criu "github.com/checkpoint-restore/go-criu/v7/utils"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
+	types "k8s.io/cri-api/pkg/apis/runtime/v1"
 
 	"github.com/cri-o/cri-o/internal/oci"
 	v2 "github.com/cri-o/cri-o/pkg/annotations/v2"
@@ -351,3 +353,54 @@ var _ = t.Describe("Oci", func() {
 		})
 	})
 })
+
+var _ = t.Describe("TruncateExecSyncResponse", func() {
+	handler := &libconfig.RuntimeHandler{ExecSyncMaxOutputSize: 4}
+
+	It("should not truncate output within the limit", func() {
+		// Given
+		resp := &types.ExecSyncResponse{Stdout: []byte("ab"), Stderr: []byte("cd")}
+
+		// When
+		oci.TruncateExecSyncResponse(getTestContainer(), handler, resp, false)
+
+		// Then
+		Expect(resp.Stdout).To(Equal([]byte("ab")))
+		Expect(resp.Stderr).To(Equal([]byte("cd")))
+	})
+
+	It("should apply the limit to the combined output", func() {
+		// Given
+		resp := &types.ExecSyncResponse{Stdout: []byte("abc"), Stderr: []byte("def")}
+
+		// When
+		oci.TruncateExecSyncResponse(getTestContainer(), handler, resp, false)
+
+		// Then
+		Expect(resp.Stdout).To(Equal([]byte("abc")))
+		Expect(resp.Stderr).To(Equal([]byte("d" + fmt.Sprintf(oci.ExecSyncTruncatedMessage, 4))))
+	})
+
+	It("should truncate stdout exceeding the limit", func() {
+		// Given
+		resp := &types.ExecSyncResponse{Stdout: []byte("abcdef"), Stderr: []byte("gh")}
+
+		// When
+		oci.TruncateExecSyncResponse(getTestContainer(), handler, resp, false)
+
+		// Then
+		Expect(resp.Stdout).To(Equal([]byte("abcd" + fmt.Sprintf(oci.ExecSyncTruncatedMessage, 4))))
+		Expect(resp.Stderr).To(Equal([]byte(fmt.Sprintf(oci.ExecSyncTruncatedMessage, 4))))
+	})
+
+	It("should mark output already truncated by the runtime", func() {
+		// Given
+		resp := &types.ExecSyncResponse{Stdout: []byte("ab")}
+
+		// When
+		oci.TruncateExecSyncResponse(getTestContainer(), handler, resp, true)
+
+		// Then
+		Expect(resp.Stdout).To(Equal([]byte("ab" + fmt.Sprintf(oci.ExecSyncTruncatedMessage, 4))))
+	})
+})
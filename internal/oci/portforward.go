@@ -0,0 +1,228 @@
+package oci
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	types "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	"github.com/cri-o/cri-o/server/metrics"
+)
+
+const (
+	// maxDatagramSize is the maximum size of a forwarded UDP datagram, which
+	// is limited by its two byte length prefix on the stream.
+	maxDatagramSize = math.MaxUint16
+
+	// datagramHeaderSize is the size of the length prefix of a datagram.
+	datagramHeaderSize = 2
+
+	// minIdleCheckInterval and maxIdleCheckInterval are the bounds of the
+	// idle port forward session check interval.
+	minIdleCheckInterval = 10 * time.Millisecond
+	maxIdleCheckInterval = time.Second
+
+	// Port forward session results for the metrics.
+	portForwardResultSuccess     = "success"
+	portForwardResultIdleTimeout = "idle_timeout"
+	portForwardResultError       = "error"
+)
+
+// ParsePortForwardUDPPorts parses the comma separated list of container ports
+// which are forwarded as UDP instead of TCP.
+func ParsePortForwardUDPPorts(value string) ([]int32, error) {
+	var ports []int32
+
+	for field := range strings.SplitSeq(value, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		port, err := strconv.ParseUint(field, 10, 16)
+		if err != nil || port == 0 {
+			return nil, fmt.Errorf("invalid port %q", field)
+		}
+
+		ports = append(ports, int32(port))
+	}
+
+	return ports, nil
+}
+
+// portForwardIdleTimeout returns the configured idle timeout of port forward
+// sessions using the provided protocol, where zero disables the timeout.
+func (r *Runtime) portForwardIdleTimeout(protocol types.Protocol) time.Duration {
+	value := r.config.StreamPortForwardIdleTimeout
+	if protocol == types.Protocol_UDP {
+		value = r.config.StreamPortForwardUDPIdleTimeout
+	}
+
+	if value == "" {
+		return 0
+	}
+
+	// The value has been validated together with the configuration.
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return 0
+	}
+
+	return timeout
+}
+
+// portForwardSession tracks the traffic of a single port forward session for
+// its idle timeout and metrics.
+type portForwardSession struct {
+	protocol      string
+	start         time.Time
+	lastActivity  atomic.Int64
+	toContainer   atomic.Uint64
+	fromContainer atomic.Uint64
+	idle          atomic.Bool
+}
+
+func newPortForwardSession(protocol types.Protocol) *portForwardSession {
+	s := &portForwardSession{
+		protocol: strings.ToLower(protocol.String()),
+		start:    time.Now(),
+	}
+	s.lastActivity.Store(s.start.UnixNano())
+
+	return s
+}
+
+// conn wraps the connection to the container port to account its traffic.
+func (s *portForwardSession) conn(conn net.Conn) net.Conn {
+	return &portForwardConn{Conn: conn, session: s}
+}
+
+// watchIdle calls cancel once the session had no traffic for the provided
+// timeout, until the context is done. A zero timeout disables the watch.
+func (s *portForwardSession) watchIdle(ctx context.Context, timeout time.Duration, cancel context.CancelFunc) {
+	if timeout <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(max(min(timeout/2, maxIdleCheckInterval), minIdleCheckInterval))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			if time.Since(time.Unix(0, s.lastActivity.Load())) >= timeout {
+				s.idle.Store(true)
+				cancel()
+
+				return
+			}
+		}
+	}
+}
+
+// finish records the metrics of the finished session.
+func (s *portForwardSession) finish(err error) {
+	result := portForwardResultSuccess
+
+	switch {
+	case s.idle.Load():
+		result = portForwardResultIdleTimeout
+	case err != nil:
+		result = portForwardResultError
+	}
+
+	metrics.Instance().MetricPortForwardSessionsInc(s.protocol, result)
+	metrics.Instance().MetricPortForwardSessionDurationObserve(s.protocol, s.start)
+	metrics.Instance().MetricPortForwardBytesAdd(s.protocol, "to_container", s.toContainer.Load())
+	metrics.Instance().MetricPortForwardBytesAdd(s.protocol, "from_container", s.fromContainer.Load())
+}
+
+// portForwardConn is a connection accounting its traffic to a port forward
+// session.
+type portForwardConn struct {
+	net.Conn
+
+	session *portForwardSession
+}
+
+func (c *portForwardConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.transferred(&c.session.fromContainer, n)
+
+	return n, err
+}
+
+func (c *portForwardConn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	c.transferred(&c.session.toContainer, n)
+
+	return n, err
+}
+
+func (c *portForwardConn) transferred(counter *atomic.Uint64, n int) {
+	if n <= 0 {
+		return
+	}
+
+	counter.Add(uint64(n))
+	c.session.lastActivity.Store(time.Now().UnixNano())
+}
+
+// copyDatagramsToContainer reads the length prefixed datagrams from the stream
+// and sends each of them as a single datagram to the container, until the
+// stream is closed.
+func copyDatagramsToContainer(conn io.Writer, stream io.Reader) error {
+	var header [datagramHeaderSize]byte
+
+	buf := make([]byte, maxDatagramSize)
+
+	for {
+		if _, err := io.ReadFull(stream, header[:]); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return fmt.Errorf("read datagram length: %w", err)
+		}
+
+		size := binary.BigEndian.Uint16(header[:])
+		if _, err := io.ReadFull(stream, buf[:size]); err != nil {
+			return fmt.Errorf("read datagram: %w", err)
+		}
+
+		if _, err := conn.Write(buf[:size]); err != nil {
+			return err
+		}
+	}
+}
+
+// copyDatagramsFromContainer receives the datagrams of the container and
+// writes them length prefixed to the stream.
+func copyDatagramsFromContainer(stream io.Writer, conn io.Reader) error {
+	buf := make([]byte, datagramHeaderSize+maxDatagramSize)
+
+	for {
+		n, err := conn.Read(buf[datagramHeaderSize:])
+		if err != nil {
+			return err
+		}
+
+		binary.BigEndian.PutUint16(buf, uint16(n))
+
+		if _, err := stream.Write(buf[:datagramHeaderSize+n]); err != nil {
+			return err
+		}
+	}
+}
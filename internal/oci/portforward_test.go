@@ -0,0 +1,154 @@
+package oci_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cri-o/cri-o/internal/oci"
+)
+
+// datagramConn returns each datagram by a single read, like a UDP socket.
+type datagramConn struct {
+	datagrams [][]byte
+	written   [][]byte
+}
+
+func (d *datagramConn) Read(b []byte) (int, error) {
+	if len(d.datagrams) == 0 {
+		return 0, io.EOF
+	}
+
+	n := copy(b, d.datagrams[0])
+	d.datagrams = d.datagrams[1:]
+
+	return n, nil
+}
+
+func (d *datagramConn) Write(b []byte) (int, error) {
+	d.written = append(d.written, bytes.Clone(b))
+
+	return len(b), nil
+}
+
+// The actual test suite.
+var _ = t.Describe("PortForward", func() {
+	t.Describe("WatchIdle", func() {
+		It("should terminate idle sessions", func() {
+			// Given
+			// When
+			idle := oci.WatchPortForwardIdle(context.Background(), 20*time.Millisecond)
+
+			// Then
+			Expect(idle).To(BeTrue())
+		})
+
+		It("should terminate idle sessions with a tiny idle timeout", func() {
+			// Given
+			// When
+			idle := oci.WatchPortForwardIdle(context.Background(), time.Nanosecond)
+
+			// Then
+			Expect(idle).To(BeTrue())
+		})
+
+		It("should stop watching once the context is done", func() {
+			// Given
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			// When
+			idle := oci.WatchPortForwardIdle(ctx, time.Hour)
+
+			// Then
+			Expect(idle).To(BeFalse())
+		})
+	})
+
+	t.Describe("ParsePortForwardUDPPorts", func() {
+		It("should succeed", func() {
+			// Given
+			// When
+			ports, err := oci.ParsePortForwardUDPPorts("53, 443,,8443")
+
+			// Then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ports).To(Equal([]int32{53, 443, 8443}))
+		})
+
+		It("should succeed with empty value", func() {
+			// Given
+			// When
+			ports, err := oci.ParsePortForwardUDPPorts("")
+
+			// Then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ports).To(BeEmpty())
+		})
+
+		It("should fail with invalid port", func() {
+			// Given
+			// When
+			_, err := oci.ParsePortForwardUDPPorts("53,dns")
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail with out of range port", func() {
+			// Given
+			// When
+			_, err := oci.ParsePortForwardUDPPorts("65536")
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	t.Describe("CopyDatagramsToContainer", func() {
+		It("should send each framed datagram separately", func() {
+			// Given
+			conn := &datagramConn{}
+			stream := bytes.NewBuffer([]byte{0, 2, 'a', 'b', 0, 0, 0, 3, 'c', 'd', 'e'})
+
+			// When
+			err := oci.CopyDatagramsToContainer(conn, stream)
+
+			// Then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(conn.written).To(Equal([][]byte{[]byte("ab"), {}, []byte("cde")}))
+		})
+
+		It("should fail on truncated datagram", func() {
+			// Given
+			conn := &datagramConn{}
+			stream := bytes.NewBuffer([]byte{0, 5, 'a', 'b'})
+
+			// When
+			err := oci.CopyDatagramsToContainer(conn, stream)
+
+			// Then
+			Expect(err).To(HaveOccurred())
+			Expect(conn.written).To(BeEmpty())
+		})
+	})
+
+	t.Describe("CopyDatagramsFromContainer", func() {
+		It("should frame each received datagram", func() {
+			// Given
+			conn := &datagramConn{datagrams: [][]byte{[]byte("ab"), []byte("cde")}}
+			stream := &bytes.Buffer{}
+
+			// When
+			err := oci.CopyDatagramsFromContainer(stream, conn)
+
+			// Then
+			Expect(err).To(MatchError(io.EOF))
+			Expect(stream.Bytes()).To(Equal([]byte{0, 2, 'a', 'b', 0, 3, 'c', 'd', 'e'}))
+		})
+	})
+})
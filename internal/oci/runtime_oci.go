@@ -35,6 +35,7 @@ import (
 	"github.com/cri-o/cri-o/internal/config/cgmgr"
 	"github.com/cri-o/cri-o/internal/lib/stats"
 	"github.com/cri-o/cri-o/internal/log"
+	"github.com/cri-o/cri-o/internal/opentelemetry"
 	"github.com/cri-o/cri-o/pkg/config"
 	"github.com/cri-o/cri-o/server/metrics"
 	"github.com/cri-o/cri-o/utils"
@@ -146,7 +147,6 @@ func (r *runtimeOCI) CreateContainer(ctx context.Context, c *Container, cgroupPa
 		"-b", c.bundlePath,
 		"-c", c.ID(),
 		"--exit-dir", r.config.ContainerExitsDir,
-		"-l", c.logPath,
 		"--log-level", logrus.GetLevel().String(),
 		"-n", c.name,
 		"-P", c.conmonPidFilePath(),
@@ -171,8 +171,8 @@ func (r *runtimeOCI) CreateContainer(ctx context.Context, c *Container, cgroupPa
 		args = append(args, "--no-sync-log")
 	}
 
-	if r.config.LogToJournald {
-		args = append(args, "--log-path", "journald:")
+	for _, driver := range logDrivers(r.handler, r.config.LogToJournald) {
+		args = append(args, driver.conmonArgs(c, r.config.ConmonSupportsLogLabel)...)
 	}
 
 	if r.config.NoPivot {
@@ -213,6 +213,7 @@ func (r *runtimeOCI) CreateContainer(ctx context.Context, c *Container, cgroupPa
 	log.WithFields(ctx, logrus.Fields{
 		"args": args,
 	}).Debugf("running conmon: %s", r.handler.MonitorPath)
+	span.SetAttributes(opentelemetry.MonitorPathKey.String(r.handler.MonitorPath))
 
 	cmd := cmdrunner.Command(r.handler.MonitorPath, args...) //nolint: gosec
 	cmd.Dir = c.bundlePath
@@ -227,6 +228,8 @@ func (r *runtimeOCI) CreateContainer(ctx context.Context, c *Container, cgroupPa
 
 	cmd.ExtraFiles = append(cmd.ExtraFiles, childPipe, childStartPipe)
 	r.prepareEnv(cmd, true)
+	// Allow conmon to continue the trace of the current span.
+	cmd.Env = append(cmd.Env, opentelemetry.Env(ctx)...)
 
 	err = cmd.Start()
 	if err != nil {
@@ -652,8 +655,16 @@ func (r *runtimeOCI) ExecSyncContainer(ctx context.Context, c *Container, comman
 		args = append(args, "--sync")
 	}
 
+	// The CRI log format adds a timestamp and the stream to every line of
+	// output, which counts against the log file size but not against the
+	// decoded output. Allow the log file to be twice the maximum output size,
+	// so that the limit applies to the decoded output like for the other
+	// runtime types.
+	maxLogSize := 2 * execSyncMaxOutputSize(r.handler)
 	if r.config.ConmonSupportsLogGlobalSizeMax() {
-		args = append(args, "--log-global-size-max", strconv.Itoa(maxExecSyncSize))
+		// Allow conmon to write one more byte than the limit to be able to
+		// detect that the output got truncated.
+		args = append(args, "--log-global-size-max", strconv.FormatInt(maxLogSize+1, 10))
 	}
 
 	if c.terminal {
@@ -719,6 +730,8 @@ func (r *runtimeOCI) ExecSyncContainer(ctx context.Context, c *Container, comman
 
 	cmd.ExtraFiles = append(cmd.ExtraFiles, childPipe, childStartPipe)
 	r.prepareEnv(cmd, true)
+	// Allow conmon to continue the trace of the current span.
+	cmd.Env = append(cmd.Env, opentelemetry.Env(ctx)...)
 
 	pid, err := c.StartExecCmd(&execCmdWrapper{cmd: cmd}, false)
 	if err != nil {
@@ -853,7 +866,7 @@ func (r *runtimeOCI) ExecSyncContainer(ctx context.Context, c *Container, comman
 	// ExecSyncResponse we have to read the logfile.
 	// XXX: Currently runC dups the same console over both stdout and stderr,
 	//      so we can't differentiate between the two.
-	logBytes, err := TruncateAndReadFile(ctx, logPath, maxExecSyncSize)
+	logBytes, truncated, err := ReadFileWithLimit(ctx, logPath, maxLogSize)
 	if err != nil {
 		return nil, &ExecSyncError{
 			Stdout:   stdoutBuf,
@@ -866,31 +879,46 @@ func (r *runtimeOCI) ExecSyncContainer(ctx context.Context, c *Container, comman
 	// We have to parse the log output into {stdout, stderr} buffers.
 	stdoutBytes, stderrBytes := parseLog(ctx, logBytes)
 
-	return &types.ExecSyncResponse{
+	resp := &types.ExecSyncResponse{
 		Stdout:   stdoutBytes,
 		Stderr:   stderrBytes,
 		ExitCode: ec.ExitCode,
-	}, nil
+	}
+	truncateExecSyncResponse(ctx, c, r.handler, resp, truncated)
+
+	return resp, nil
 }
 
-func TruncateAndReadFile(ctx context.Context, path string, size int64) ([]byte, error) {
+// ReadFileWithLimit reads up to size bytes from the file at path. It returns
+// true if the file is larger than size and has therefore not been read
+// completely.
+func ReadFileWithLimit(ctx context.Context, path string, size int64) (content []byte, truncated bool, err error) {
 	ctx, span := log.StartSpan(ctx)
 	defer span.End()
 
-	info, err := os.Stat(path)
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, false, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	if info.Size() > size {
-		log.Errorf(ctx, "Exec sync output in file %s has size %d which is longer than expected size of %d", path, info.Size(), size)
+		log.Debugf(ctx, "Exec sync output in file %s has size %d which is longer than expected size of %d", path, info.Size(), size)
 
-		if err := os.Truncate(path, size); err != nil {
-			return nil, err
-		}
+		truncated = true
+	}
+
+	content, err = io.ReadAll(io.LimitReader(f, size))
+	if err != nil {
+		return nil, false, err
 	}
 
-	return os.ReadFile(path)
+	return content, truncated, nil
 }
 
 // UpdateContainer updates container resources.
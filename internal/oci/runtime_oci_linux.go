@@ -8,23 +8,29 @@ import (
 	"io"
 	"net"
 	"os/exec"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/containernetworking/plugins/pkg/ns"
+	types "k8s.io/cri-api/pkg/apis/runtime/v1"
 
 	"github.com/cri-o/cri-o/internal/log"
 )
 
 // PortForwardContainer forwards the specified port into the provided container.
-func (r *runtimeOCI) PortForwardContainer(ctx context.Context, c *Container, netNsPath string, port int32, stream io.ReadWriteCloser) error {
+// UDP datagrams are framed on the stream by a two byte length prefix.
+func (r *runtimeOCI) PortForwardContainer(ctx context.Context, c *Container, netNsPath string, port int32, protocol types.Protocol, stream io.ReadWriteCloser) (retErr error) {
 	ctx, span := log.StartSpan(ctx)
 	defer span.End()
 
 	log.Infof(ctx,
-		"Starting port forward for %s in network namespace %s", c.ID(), netNsPath,
+		"Starting %s port forward for %s in network namespace %s", protocol, c.ID(), netNsPath,
 	)
 
+	session := newPortForwardSession(protocol)
+	defer func() { session.finish(retErr) }()
+
 	// Adapted reference implementation:
 	// https://github.com/containerd/cri/blob/8c366d/pkg/server/sandbox_portforward_unix.go#L65-L120
 	if err := ns.WithNetNSPath(netNsPath, func(_ ns.NetNS) error {
@@ -42,23 +48,40 @@ func (r *runtimeOCI) PortForwardContainer(ctx context.Context, c *Container, net
 		// xref https://github.com/golang/go/issues/44922
 		var d net.Dialer
 		d.FallbackDelay = -1
-		conn, err := d.Dial("tcp", fmt.Sprintf("localhost:%d", port))
+		network := strings.ToLower(protocol.String())
+
+		dialedConn, err := d.Dial(network, fmt.Sprintf("localhost:%d", port))
 		if err != nil {
 			return fmt.Errorf("failed to connect to localhost:%d inside namespace %s: %w", port, c.ID(), err)
 		}
-		defer conn.Close()
+		defer dialedConn.Close()
+
+		conn := session.conn(dialedConn)
+
+		// Terminate the session if it has no traffic for the idle timeout
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		go session.watchIdle(ctx, r.portForwardIdleTimeout(protocol), cancel)
 
 		errCh := make(chan error, 2)
 
 		debug := func(format string, args ...any) {
 			log.Debugf(ctx, fmt.Sprintf(
-				"PortForward (id: %s, port: %d): %s", c.ID(), port, format,
+				"PortForward (id: %s, port: %d, protocol: %s): %s", c.ID(), port, protocol, format,
 			), args...)
 		}
 
 		// Copy from the namespace port connection to the client stream
 		go func() {
 			debug("copy data from container to client")
+
+			if protocol == types.Protocol_UDP {
+				errCh <- copyDatagramsFromContainer(stream, conn)
+
+				return
+			}
+
 			_, err := io.Copy(stream, conn)
 			errCh <- err
 		}()
@@ -66,6 +89,13 @@ func (r *runtimeOCI) PortForwardContainer(ctx context.Context, c *Container, net
 		// Copy from the client stream to the namespace port connection
 		go func() {
 			debug("copy data from client to container")
+
+			if protocol == types.Protocol_UDP {
+				errCh <- copyDatagramsToContainer(conn, stream)
+
+				return
+			}
+
 			_, err := io.Copy(conn, stream)
 			errCh <- err
 		}()
@@ -78,6 +108,12 @@ func (r *runtimeOCI) PortForwardContainer(ctx context.Context, c *Container, net
 		case errFwd = <-errCh:
 			debug("stop forwarding in direction: %v", errFwd)
 		case <-ctx.Done():
+			if session.idle.Load() {
+				debug("idle timeout reached")
+
+				return nil
+			}
+
 			debug("cancelled: %v", ctx.Err())
 
 			return ctx.Err()
@@ -107,7 +143,7 @@ func (r *runtimeOCI) PortForwardContainer(ctx context.Context, c *Container, net
 		)
 	}
 
-	log.Infof(ctx, "Finished port forwarding for %q on port %d", c.ID(), port)
+	log.Infof(ctx, "Finished %s port forwarding for %q on port %d", protocol, c.ID(), port)
 
 	return nil
 }
@@ -181,13 +181,13 @@ var _ = t.Describe("Oci", func() {
 			verifyContainerNotStopped(sut)
 		})
 	})
-	Context("TruncateAndReadFile", func() {
+	Context("ReadFileWithLimit", func() {
 		tests := []struct {
-			title    string
-			contents []byte
-			expected []byte
-			fail     bool
-			size     int64
+			title     string
+			contents  []byte
+			expected  []byte
+			truncated bool
+			size      int64
 		}{
 			{
 				title:    "should read file if size is smaller than limit",
@@ -202,19 +202,26 @@ var _ = t.Describe("Oci", func() {
 				size:     4,
 			},
 			{
-				title:    "should read only size if size is larger than limit",
-				contents: []byte("abcd"),
-				expected: []byte("abc"),
-				size:     3,
+				title:     "should read only size if size is larger than limit",
+				contents:  []byte("abcd"),
+				expected:  []byte("abc"),
+				truncated: true,
+				size:      3,
 			},
 		}
 		for _, test := range tests {
 			It(test.title, func() {
 				fileName := t.MustTempFile("to-read")
 				Expect(os.WriteFile(fileName, test.contents, 0o644)).To(Succeed())
-				found, err := oci.TruncateAndReadFile(context.Background(), fileName, test.size)
+				found, truncated, err := oci.ReadFileWithLimit(context.Background(), fileName, test.size)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(Equal(test.expected))
+				Expect(truncated).To(Equal(test.truncated))
+
+				// the file itself should not be modified
+				contents, err := os.ReadFile(fileName)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(contents).To(Equal(test.contents))
 			})
 		}
 	})
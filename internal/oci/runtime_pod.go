@@ -162,14 +162,13 @@ func (r *runtimePod) CreateContainer(ctx context.Context, c *Container, cgroupPa
 		Stdin:        c.stdin,
 		ExitPaths:    []string{filepath.Join(r.oci.config.ContainerExitsDir, c.ID()), c.exitFilePath()},
 		OOMExitPaths: []string{filepath.Join(c.bundlePath, "oom")}, // Keep in sync with location in oci.UpdateContainerStatus()
-		LogDrivers: []conmonClient.ContainerLogDriver{
-			{
-				Type:    conmonClient.LogDriverTypeContainerRuntimeInterface,
-				Path:    c.logPath,
-				MaxSize: maxSize,
-			},
-		},
 	}
+
+	// The global log_to_journald option only applies to conmon.
+	for _, driver := range logDrivers(r.oci.handler, false) {
+		createConfig.LogDrivers = append(createConfig.LogDrivers, driver.conmonrsLogDriver(c, maxSize))
+	}
+
 	resp, err := r.client.CreateContainer(ctx, createConfig)
 	// TODO FIXME do we need to cleanup the container?
 	if err != nil {
@@ -208,21 +207,36 @@ func (r *runtimePod) StartContainer(ctx context.Context, c *Container) error {
 	return r.oci.StartContainer(ctx, c)
 }
 
+// CheckpointContainer checkpoints a container.
+// The checkpoint is taken by the OCI runtime directly, conmon-rs notices the
+// exit of the dumped container like for any other container exit.
 func (r *runtimePod) CheckpointContainer(
 	ctx context.Context,
 	c *Container,
 	specgen *rspec.Spec,
 	leaveRunning bool,
 ) error {
+	if c.Spoofed() {
+		return errors.New("cannot checkpoint a spoofed container")
+	}
+
 	return r.oci.CheckpointContainer(ctx, c, specgen, leaveRunning)
 }
 
+// RestoreContainer restores a container.
+// The restore is done by the OCI runtime implementation, which moves the
+// restored container into the cgroup parent and labels the restore log with
+// the mount label.
 func (r *runtimePod) RestoreContainer(
 	ctx context.Context,
 	c *Container,
 	cgroupParent string,
 	mountLabel string,
 ) error {
+	if c.Spoofed() {
+		return errors.New("cannot restore a spoofed container")
+	}
+
 	return r.oci.RestoreContainer(ctx, c, cgroupParent, mountLabel)
 }
 
@@ -256,11 +270,16 @@ func (r *runtimePod) ExecSyncContainer(ctx context.Context, c *Container, cmd []
 		}, nil
 	}
 
-	return &types.ExecSyncResponse{
+	// conmon-rs does not support limiting the output, which therefore gets
+	// truncated only after receiving it completely.
+	resp := &types.ExecSyncResponse{
 		ExitCode: res.ExitCode,
 		Stdout:   res.Stdout,
 		Stderr:   res.Stderr,
-	}, nil
+	}
+	truncateExecSyncResponse(ctx, c, r.oci.handler, resp, false)
+
+	return resp, nil
 }
 
 func (r *runtimePod) UpdateContainer(ctx context.Context, c *Container, res *rspec.LinuxResources) error {
@@ -350,8 +369,8 @@ func (r *runtimePod) AttachContainer(ctx context.Context, c *Container, inputStr
 	})
 }
 
-func (r *runtimePod) PortForwardContainer(ctx context.Context, c *Container, netNsPath string, port int32, stream io.ReadWriteCloser) error {
-	return r.oci.PortForwardContainer(ctx, c, netNsPath, port, stream)
+func (r *runtimePod) PortForwardContainer(ctx context.Context, c *Container, netNsPath string, port int32, protocol types.Protocol, stream io.ReadWriteCloser) error {
+	return r.oci.PortForwardContainer(ctx, c, netNsPath, port, protocol, stream)
 }
 
 func (r *runtimePod) ReopenContainerLog(ctx context.Context, c *Container) error {
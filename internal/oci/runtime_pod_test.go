@@ -0,0 +1,120 @@
+package oci_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	criu "github.com/checkpoint-restore/go-criu/v7/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	rspec "github.com/opencontainers/runtime-spec/specs-go"
+
+	"github.com/cri-o/cri-o/internal/oci"
+	libconfig "github.com/cri-o/cri-o/pkg/config"
+)
+
+// The actual test suite.
+var _ = t.Describe("RuntimePod", func() {
+	var sut oci.RuntimePod
+
+	newRuntimePod := func(runtimePath string) oci.RuntimePod {
+		cfg, err := libconfig.DefaultConfig()
+		Expect(err).ToNot(HaveOccurred())
+		cfg.Conmon = "/bin/true"
+		r, err := oci.New(cfg)
+		Expect(err).ToNot(HaveOccurred())
+
+		return oci.NewRuntimePod(r, &libconfig.RuntimeHandler{
+			RuntimePath: runtimePath,
+			RuntimeType: libconfig.RuntimeTypePod,
+			MonitorPath: "/bin/true",
+		})
+	}
+
+	BeforeEach(func() {
+		sut = newRuntimePod("/bin/false")
+	})
+
+	t.Describe("CheckpointContainer", func() {
+		It("should fail with spoofed container", func() {
+			// Given
+			ctr := oci.NewSpoofedContainer("id", "name", nil, "sandbox", time.Now(), "dir")
+
+			// When
+			err := sut.CheckpointContainer(context.Background(), ctr, &rspec.Spec{}, false)
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail if the runtime does not support checkpointing", func() {
+			// Given
+			ctr := getTestContainer()
+
+			// When
+			err := sut.CheckpointContainer(context.Background(), ctr, &rspec.Spec{Linux: &rspec.Linux{}}, false)
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	t.Describe("RestoreContainer", func() {
+		It("should fail with spoofed container", func() {
+			// Given
+			ctr := oci.NewSpoofedContainer("id", "name", nil, "sandbox", time.Now(), "dir")
+
+			// When
+			err := sut.RestoreContainer(context.Background(), ctr, "", "")
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail with missing inventory", func() {
+			if err := criu.CheckForCriu(criu.PodCriuVersion); err != nil {
+				Skip("Check CRIU: " + err.Error())
+			}
+			// Given
+			sut = newRuntimePod("/bin/true")
+			ctr := getTestContainer()
+
+			// When
+			err := sut.RestoreContainer(context.Background(), ctr, "no-parent-cgroup-exists", "label")
+
+			// Then
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("a complete checkpoint for this container cannot be found"))
+		})
+
+		It("should restore through the OCI runtime", func() {
+			if err := criu.CheckForCriu(criu.PodCriuVersion); err != nil {
+				Skip("Check CRIU: " + err.Error())
+			}
+			// Given
+			sut = newRuntimePod("/bin/true")
+			ctr := getTestContainer()
+			ctr.SetSpec(&rspec.Spec{
+				Version:     "1.0.0",
+				Annotations: map[string]string{"io.kubernetes.cri-o.SandboxID": "sandboxID"},
+				Linux:       &rspec.Linux{MountLabel: "."},
+				Process:     &rspec.Process{},
+			})
+
+			Expect(os.MkdirAll(ctr.CheckpointPath(), 0o700)).To(Succeed())
+			defer os.RemoveAll(ctr.Dir())
+			Expect(os.WriteFile(filepath.Join(ctr.CheckpointPath(), "inventory.img"), nil, 0o644)).To(Succeed())
+			Expect(os.MkdirAll(ctr.BundlePath(), 0o700)).To(Succeed())
+			defer os.RemoveAll(ctr.BundlePath())
+
+			// When
+			err := sut.RestoreContainer(context.Background(), ctr, "no-parent-cgroup-exists", "label")
+
+			// Then
+			Expect(err).To(HaveOccurred())
+			Expect(filepath.Join(ctr.BundlePath(), "restore.log")).To(BeAnExistingFile())
+		})
+	})
+})
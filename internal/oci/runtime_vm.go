@@ -403,8 +403,10 @@ func (r *runtimeVM) ExecSyncContainer(ctx context.Context, c *Container, command
 
 	var stdoutBuf, stderrBuf bytes.Buffer
 
-	stdout := &writeCloserWrapper{limitWriter(&stdoutBuf, maxExecSyncSize)}
-	stderr := &writeCloserWrapper{limitWriter(&stderrBuf, maxExecSyncSize)}
+	// Allow one more byte than the limit to be able to detect truncated output.
+	maxOutputSize := execSyncMaxOutputSize(r.handler) + 1
+	stdout := &writeCloserWrapper{limitWriter(&stdoutBuf, maxOutputSize)}
+	stderr := &writeCloserWrapper{limitWriter(&stderrBuf, maxOutputSize)}
 
 	exitCode, err := r.execContainerCommon(ctx, c, command, timeout, nil, stdout, stderr, c.terminal, nil)
 	if err != nil {
@@ -419,11 +421,14 @@ func (r *runtimeVM) ExecSyncContainer(ctx context.Context, c *Container, command
 		}, nil
 	}
 
-	return &types.ExecSyncResponse{
+	resp := &types.ExecSyncResponse{
 		Stdout:   stdoutBuf.Bytes(),
 		Stderr:   stderrBuf.Bytes(),
 		ExitCode: exitCode,
-	}, nil
+	}
+	truncateExecSyncResponse(ctx, c, r.handler, resp, false)
+
+	return resp, nil
 }
 
 // limitWriter is a copy of the standard library ioutils.LimitReader,
@@ -1080,7 +1085,7 @@ func (r *runtimeVM) AttachContainer(ctx context.Context, c *Container, inputStre
 }
 
 // PortForwardContainer forwards the specified port provides statistics of a container.
-func (r *runtimeVM) PortForwardContainer(ctx context.Context, c *Container, netNsPath string, port int32, stream io.ReadWriteCloser) error {
+func (r *runtimeVM) PortForwardContainer(ctx context.Context, c *Container, netNsPath string, port int32, protocol types.Protocol, stream io.ReadWriteCloser) error {
 	log.Debugf(ctx, "RuntimeVM.PortForwardContainer() start")
 	defer log.Debugf(ctx, "RuntimeVM.PortForwardContainer() end")
 
@@ -0,0 +1,207 @@
+package oomforensics
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cri-o/cri-o/internal/config/cgmgr"
+	"github.com/cri-o/cri-o/internal/config/node"
+	"github.com/cri-o/cri-o/pkg/types"
+)
+
+const procPath = "/proc"
+
+// limitFiles are the cgroup files recorded as limits, if they exist.
+var limitFiles = []string{
+	// cgroup v2
+	"memory.max",
+	"memory.high",
+	"memory.low",
+	"memory.min",
+	"memory.swap.max",
+	"pids.max",
+	// cgroup v1
+	"memory.limit_in_bytes",
+	"memory.soft_limit_in_bytes",
+	"memory.memsw.limit_in_bytes",
+}
+
+// MemoryCgroupDir returns the directory of the memory controller for the
+// provided absolute container cgroup path.
+func MemoryCgroupDir(cgroupPath string) string {
+	if node.CgroupIsV2() {
+		return filepath.Join(cgmgr.CgroupMemoryPathV2, cgroupPath)
+	}
+
+	return filepath.Join(cgmgr.CgroupMemoryPathV1, cgroupPath)
+}
+
+// Capture collects the forensics of the container using the provided memory
+// cgroup directory.
+func Capture(memoryDir string) (*types.OOMForensics, error) {
+	return capture(memoryDir, procPath)
+}
+
+func capture(memoryDir, procDir string) (*types.OOMForensics, error) {
+	memoryStat, err := readKeyValueFile(filepath.Join(memoryDir, "memory.stat"))
+	if err != nil {
+		return nil, err
+	}
+
+	memoryEvents, err := readMemoryEvents(memoryDir)
+	if err != nil {
+		return nil, err
+	}
+
+	limits := map[string]string{}
+
+	for _, name := range limitFiles {
+		content, err := os.ReadFile(filepath.Join(memoryDir, name))
+		if err != nil {
+			continue
+		}
+
+		limits[name] = strings.TrimSpace(string(content))
+	}
+
+	topProcesses, err := readTopProcesses(memoryDir, procDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.OOMForensics{
+		CapturedAt:   time.Now().UnixNano(),
+		MemoryStat:   memoryStat,
+		MemoryEvents: memoryEvents,
+		Limits:       limits,
+		TopProcesses: topProcesses,
+	}, nil
+}
+
+// readMemoryEvents returns the memory events of cgroup v2, or the out of
+// memory control counters of cgroup v1.
+func readMemoryEvents(memoryDir string) (map[string]uint64, error) {
+	events, err := readKeyValueFile(filepath.Join(memoryDir, "memory.events"))
+	if errors.Is(err, os.ErrNotExist) {
+		return readKeyValueFile(filepath.Join(memoryDir, "memory.oom_control"))
+	}
+
+	return events, err
+}
+
+// OOMKills returns the amount of processes killed by the out of memory killer
+// in the provided memory cgroup directory.
+func OOMKills(memoryDir string) (uint64, error) {
+	events, err := readMemoryEvents(memoryDir)
+	if err != nil {
+		return 0, err
+	}
+
+	return events["oom_kill"], nil
+}
+
+// readKeyValueFile parses cgroup files consisting of lines with a key and a
+// numeric value, like memory.stat or memory.events.
+func readKeyValueFile(path string) (map[string]uint64, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]uint64{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		values[fields[0]] = value
+	}
+
+	return values, scanner.Err()
+}
+
+// readTopProcesses returns the processes of the cgroup with the highest
+// resident set size. Processes exiting while being read are skipped.
+func readTopProcesses(memoryDir, procDir string) ([]types.OOMProcess, error) {
+	content, err := os.ReadFile(filepath.Join(memoryDir, "cgroup.procs"))
+	if err != nil {
+		return nil, fmt.Errorf("read cgroup processes: %w", err)
+	}
+
+	processes := []types.OOMProcess{}
+
+	for pidString := range strings.FieldsSeq(string(content)) {
+		pid, err := strconv.Atoi(pidString)
+		if err != nil {
+			continue
+		}
+
+		process, err := readProcess(procDir, pid)
+		if err != nil {
+			continue
+		}
+
+		processes = append(processes, process)
+	}
+
+	slices.SortFunc(processes, func(a, b types.OOMProcess) int {
+		return cmp.Compare(b.RSSBytes, a.RSSBytes)
+	})
+
+	if len(processes) > topProcessesCount {
+		processes = processes[:topProcessesCount]
+	}
+
+	return processes, nil
+}
+
+// readProcess reads the command and resident set size of the process from
+// its status file.
+func readProcess(procDir string, pid int) (types.OOMProcess, error) {
+	process := types.OOMProcess{Pid: pid}
+
+	f, err := os.Open(filepath.Join(procDir, strconv.Itoa(pid), "status"))
+	if err != nil {
+		return process, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+
+		switch key {
+		case "Name":
+			process.Command = strings.TrimSpace(value)
+		case "VmRSS":
+			kb, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimSpace(value), " kB"), 10, 64)
+			if err != nil {
+				return process, fmt.Errorf("parse resident set size: %w", err)
+			}
+
+			process.RSSBytes = kb * 1024
+		}
+	}
+
+	return process, scanner.Err()
+}
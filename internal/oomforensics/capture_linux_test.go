@@ -0,0 +1,137 @@
+package oomforensics
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/cri-o/cri-o/pkg/types"
+)
+
+// The actual test suite.
+var _ = t.Describe("Capture", func() {
+	var memoryDir, procDir string
+
+	writeFile := func(path, content string) {
+		Expect(os.MkdirAll(filepath.Dir(path), 0o755)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(content), 0o644)).To(Succeed())
+	}
+
+	writeProcess := func(pid int, name string, rssKB uint64) {
+		writeFile(filepath.Join(procDir, fmt.Sprint(pid), "status"),
+			fmt.Sprintf("Name:\t%s\nPid:\t%d\nVmRSS:\t%d kB\n", name, pid, rssKB))
+	}
+
+	BeforeEach(func() {
+		memoryDir = t.MustTempDir("memory")
+		procDir = t.MustTempDir("proc")
+	})
+
+	It("should capture the cgroup v2 memory state", func() {
+		// Given
+		writeFile(filepath.Join(memoryDir, "memory.stat"), "anon 1024\nfile 2048\n")
+		writeFile(filepath.Join(memoryDir, "memory.events"), "low 0\nhigh 0\nmax 3\noom 1\noom_kill 1\n")
+		writeFile(filepath.Join(memoryDir, "memory.max"), "104857600\n")
+		writeFile(filepath.Join(memoryDir, "memory.swap.max"), "max\n")
+		writeFile(filepath.Join(memoryDir, "cgroup.procs"), "10\n20\n30\n")
+		writeProcess(10, "init", 100)
+		writeProcess(20, "worker", 300)
+		// process 30 exited before it got read
+
+		// When
+		forensics, err := capture(memoryDir, procDir)
+
+		// Then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(forensics.CapturedAt).NotTo(BeZero())
+		Expect(forensics.MemoryStat).To(Equal(map[string]uint64{"anon": 1024, "file": 2048}))
+		Expect(forensics.MemoryEvents).To(HaveKeyWithValue("oom_kill", uint64(1)))
+		Expect(forensics.Limits).To(Equal(map[string]string{"memory.max": "104857600", "memory.swap.max": "max"}))
+		Expect(forensics.TopProcesses).To(Equal([]types.OOMProcess{
+			{Pid: 20, Command: "worker", RSSBytes: 300 * 1024},
+			{Pid: 10, Command: "init", RSSBytes: 100 * 1024},
+		}))
+	})
+
+	It("should read the cgroup v1 OOM control counters", func() {
+		// Given
+		writeFile(filepath.Join(memoryDir, "memory.oom_control"), "oom_kill_disable 0\nunder_oom 0\noom_kill 2\n")
+
+		// When
+		oomKills, err := OOMKills(memoryDir)
+
+		// Then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(oomKills).To(BeEquivalentTo(2))
+	})
+
+	It("should limit the amount of recorded processes", func() {
+		// Given
+		writeFile(filepath.Join(memoryDir, "memory.stat"), "anon 0\n")
+		writeFile(filepath.Join(memoryDir, "memory.events"), "oom_kill 1\n")
+
+		pids := ""
+		for pid := 1; pid <= topProcessesCount+5; pid++ {
+			writeProcess(pid, "process", uint64(pid))
+			pids += fmt.Sprintf("%d\n", pid)
+		}
+
+		writeFile(filepath.Join(memoryDir, "cgroup.procs"), pids)
+
+		// When
+		forensics, err := capture(memoryDir, procDir)
+
+		// Then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(forensics.TopProcesses).To(HaveLen(topProcessesCount))
+		Expect(forensics.TopProcesses[0].Pid).To(Equal(topProcessesCount + 5))
+	})
+
+	It("should fail without memory cgroup", func() {
+		// Given
+		// When
+		_, err := capture(filepath.Join(memoryDir, "removed"), procDir)
+
+		// Then
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = t.Describe("Read", func() {
+	It("should read the written forensics", func() {
+		// Given
+		dir := t.MustTempDir("run")
+		forensics := &types.OOMForensics{
+			CapturedAt:   1,
+			MemoryStat:   map[string]uint64{"anon": 1},
+			MemoryEvents: map[string]uint64{"oom_kill": 1},
+			Limits:       map[string]string{"memory.max": "max"},
+			TopProcesses: []types.OOMProcess{{Pid: 1, Command: "init", RSSBytes: 1024}},
+		}
+		Expect(Write(dir, forensics)).To(Succeed())
+
+		// When
+		res, err := Read(dir)
+
+		// Then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res).To(Equal(forensics))
+		Expect(Exists(dir)).To(BeTrue())
+	})
+
+	It("should return nothing if no forensics got captured", func() {
+		// Given
+		dir := t.MustTempDir("run")
+
+		// When
+		res, err := Read(dir)
+
+		// Then
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res).To(BeNil())
+		Expect(Exists(dir)).To(BeFalse())
+	})
+})
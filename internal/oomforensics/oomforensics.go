@@ -0,0 +1,62 @@
+// Package oomforensics captures the memory state of containers at the time
+// they got out of memory killed, so that it can be inspected afterwards.
+package oomforensics
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/cri-o/cri-o/pkg/types"
+)
+
+// fileName is the name of the forensics file in the container run dir.
+const fileName = "oom-forensics.json"
+
+// topProcessesCount is the amount of processes with the highest resident set
+// size recorded in the forensics.
+const topProcessesCount = 10
+
+// Write stores the forensics in the provided container run dir.
+func Write(dir string, forensics *types.OOMForensics) error {
+	data, err := json.Marshal(forensics)
+	if err != nil {
+		return fmt.Errorf("marshal OOM forensics: %w", err)
+	}
+
+	tmpPath := filepath.Join(dir, "."+fileName)
+	if err := os.WriteFile(tmpPath, data, 0o600); err != nil {
+		return fmt.Errorf("write OOM forensics: %w", err)
+	}
+
+	return os.Rename(tmpPath, filepath.Join(dir, fileName))
+}
+
+// Read returns the forensics stored in the provided container run dir, or nil
+// if none got captured.
+func Read(dir string) (*types.OOMForensics, error) {
+	data, err := os.ReadFile(filepath.Join(dir, fileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("read OOM forensics: %w", err)
+	}
+
+	forensics := &types.OOMForensics{}
+	if err := json.Unmarshal(data, forensics); err != nil {
+		return nil, fmt.Errorf("unmarshal OOM forensics: %w", err)
+	}
+
+	return forensics, nil
+}
+
+// Exists returns true if forensics got captured in the provided container run
+// dir.
+func Exists(dir string) bool {
+	_, err := os.Stat(filepath.Join(dir, fileName))
+
+	return err == nil
+}
@@ -0,0 +1,45 @@
+//go:build !linux
+
+package oomforensics
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cri-o/cri-o/pkg/types"
+)
+
+var errUnsupported = errors.New("OOM forensics are only supported on linux")
+
+// MemoryCgroupDir returns the directory of the memory controller for the
+// provided absolute container cgroup path.
+func MemoryCgroupDir(cgroupPath string) string {
+	return cgroupPath
+}
+
+// Capture collects the forensics of the container using the provided memory
+// cgroup directory.
+func Capture(string) (*types.OOMForensics, error) {
+	return nil, errUnsupported
+}
+
+// Watcher does nothing on unsupported platforms.
+type Watcher struct{}
+
+// NewWatcher creates a new watcher.
+func NewWatcher(context.Context) (*Watcher, error) {
+	return nil, errUnsupported
+}
+
+// Watch does nothing on unsupported platforms.
+func (*Watcher) Watch(_, _, _ string) error {
+	return nil
+}
+
+// Unwatch does nothing on unsupported platforms.
+func (*Watcher) Unwatch(string) {}
+
+// Close does nothing on unsupported platforms.
+func (*Watcher) Close() error {
+	return nil
+}
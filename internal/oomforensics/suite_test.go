@@ -0,0 +1,27 @@
+package oomforensics
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/cri-o/cri-o/test/framework"
+)
+
+// TestOOMForensics runs the created specs.
+func TestOOMForensics(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunFrameworkSpecs(t, "OOMForensics")
+}
+
+var t *TestFramework
+
+var _ = BeforeSuite(func() {
+	t = NewTestFramework(NilFunc, NilFunc)
+	t.Setup()
+})
+
+var _ = AfterSuite(func() {
+	t.Teardown()
+})
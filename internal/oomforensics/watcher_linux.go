@@ -0,0 +1,169 @@
+package oomforensics
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/cri-o/cri-o/internal/config/node"
+	"github.com/cri-o/cri-o/internal/log"
+)
+
+// watchedContainer is a container whose memory events are watched.
+type watchedContainer struct {
+	id        string
+	memoryDir string
+	runDir    string
+	oomKills  uint64
+}
+
+// Watcher captures the forensics of containers as soon as the out of memory
+// killer kills one of their processes, by watching the cgroup v2
+// memory.events files. A nil Watcher is valid and does nothing.
+type Watcher struct {
+	ctx     context.Context
+	watcher *fsnotify.Watcher
+
+	mutex sync.Mutex
+	// containers are the watched containers by memory.events path.
+	containers map[string]*watchedContainer
+	// paths are the watched memory.events paths by container ID.
+	paths map[string]string
+}
+
+// NewWatcher creates a new watcher and starts processing its events.
+func NewWatcher(ctx context.Context) (*Watcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("create OOM forensics watcher: %w", err)
+	}
+
+	w := &Watcher{
+		ctx:        ctx,
+		watcher:    watcher,
+		containers: make(map[string]*watchedContainer),
+		paths:      make(map[string]string),
+	}
+	go w.run()
+
+	return w, nil
+}
+
+// Watch starts watching the memory events of the container with the provided
+// memory cgroup directory. The forensics get stored in the run dir. On cgroup
+// v1, the cgroup files do not support inotify, so nothing is watched.
+func (w *Watcher) Watch(id, memoryDir, runDir string) error {
+	if w == nil || !node.CgroupIsV2() {
+		return nil
+	}
+
+	oomKills, err := OOMKills(memoryDir)
+	if err != nil {
+		return fmt.Errorf("read OOM kills of container %s: %w", id, err)
+	}
+
+	path := filepath.Join(memoryDir, "memory.events")
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if err := w.watcher.Add(path); err != nil {
+		return fmt.Errorf("watch memory events of container %s: %w", id, err)
+	}
+
+	w.containers[path] = &watchedContainer{id: id, memoryDir: memoryDir, runDir: runDir, oomKills: oomKills}
+	w.paths[id] = path
+
+	return nil
+}
+
+// Unwatch stops watching the memory events of the container.
+func (w *Watcher) Unwatch(id string) {
+	if w == nil {
+		return
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	path, ok := w.paths[id]
+	if !ok {
+		return
+	}
+
+	delete(w.paths, id)
+	delete(w.containers, path)
+
+	// The watch is removed automatically if the cgroup got removed already.
+	if err := w.watcher.Remove(path); err != nil {
+		log.Debugf(w.ctx, "Unable to remove memory events watch of container %s: %v", id, err)
+	}
+}
+
+// Close stops the watcher.
+func (w *Watcher) Close() error {
+	if w == nil {
+		return nil
+	}
+
+	return w.watcher.Close()
+}
+
+func (w *Watcher) run() {
+	for {
+		select {
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+
+			if event.Has(fsnotify.Write) {
+				w.handle(event.Name)
+			}
+
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+
+			log.Warnf(w.ctx, "OOM forensics watch error: %v", err)
+		}
+	}
+}
+
+// handle captures the forensics of the container if its amount of OOM kills
+// increased.
+func (w *Watcher) handle(path string) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	c, ok := w.containers[path]
+	if !ok {
+		return
+	}
+
+	oomKills, err := OOMKills(c.memoryDir)
+	if err != nil || oomKills <= c.oomKills {
+		return
+	}
+
+	c.oomKills = oomKills
+
+	forensics, err := Capture(c.memoryDir)
+	if err != nil {
+		log.Warnf(w.ctx, "Unable to capture OOM forensics of container %s: %v", c.id, err)
+
+		return
+	}
+
+	if err := Write(c.runDir, forensics); err != nil {
+		log.Warnf(w.ctx, "Unable to store OOM forensics of container %s: %v", c.id, err)
+
+		return
+	}
+
+	log.Infof(w.ctx, "Captured OOM forensics of container %s", c.id)
+}
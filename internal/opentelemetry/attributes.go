@@ -0,0 +1,72 @@
+package opentelemetry
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// Semantic span attributes used across CRI-O.
+const (
+	// ContainerIDKey is the ID of the container the span refers to.
+	ContainerIDKey = attribute.Key("crio.container.id")
+
+	// PodIDKey is the ID of the pod sandbox the span refers to.
+	PodIDKey = attribute.Key("crio.pod.id")
+
+	// ImageReferenceKey is the reference of the image being pulled.
+	ImageReferenceKey = attribute.Key("crio.image.reference")
+
+	// ImagePullBytesKey is the amount of bytes transferred during an image
+	// pull.
+	ImagePullBytesKey = attribute.Key("crio.image.pull.bytes")
+
+	// CNINetworkKey is the name of the CNI network used for a pod.
+	CNINetworkKey = attribute.Key("crio.cni.network")
+
+	// CNIPluginsKey are the CNI plugin types invoked for a pod.
+	CNIPluginsKey = attribute.Key("crio.cni.plugins")
+
+	// CNIVersionKey is the CNI version of the plugin result.
+	CNIVersionKey = attribute.Key("crio.cni.version")
+
+	// CNIInterfacesKey are the interface names of the CNI result.
+	CNIInterfacesKey = attribute.Key("crio.cni.interfaces")
+
+	// CNIIPsKey are the IP addresses of the CNI result.
+	CNIIPsKey = attribute.Key("crio.cni.ips")
+
+	// RuntimeHandlerKey is the runtime handler used for a container.
+	RuntimeHandlerKey = attribute.Key("crio.runtime.handler")
+
+	// RuntimePathKey is the path to the OCI runtime binary.
+	RuntimePathKey = attribute.Key("crio.runtime.path")
+
+	// MonitorPathKey is the path to the container monitor binary.
+	MonitorPathKey = attribute.Key("crio.monitor.path")
+
+	// ExitCodeKey is the exit code of a container or exec process.
+	ExitCodeKey = attribute.Key("crio.exit_code")
+)
+
+// Env returns the trace context of the provided context as environment
+// variables, for example TRACEPARENT=…, by using the global text map
+// propagator. This allows child processes like conmon to continue the trace.
+func Env(ctx context.Context) []string {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	keys := carrier.Keys()
+	slices.Sort(keys)
+
+	env := make([]string, 0, len(keys))
+	for _, key := range keys {
+		env = append(env, strings.ToUpper(key)+"="+carrier.Get(key))
+	}
+
+	return env
+}
@@ -0,0 +1,58 @@
+package opentelemetry_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/cri-o/cri-o/internal/opentelemetry"
+)
+
+// The actual test suite.
+var _ = t.Describe("Env", func() {
+	var propagator propagation.TextMapPropagator
+
+	BeforeEach(func() {
+		propagator = otel.GetTextMapPropagator()
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+	})
+
+	AfterEach(func() {
+		otel.SetTextMapPropagator(propagator)
+	})
+
+	It("should return the trace context as environment variables", func() {
+		// Given
+		traceID, err := trace.TraceIDFromHex("0102030405060708090a0b0c0d0e0f10")
+		Expect(err).NotTo(HaveOccurred())
+		spanID, err := trace.SpanIDFromHex("0102030405060708")
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: trace.FlagsSampled,
+		}))
+
+		// When
+		env := opentelemetry.Env(ctx)
+
+		// Then
+		Expect(env).To(Equal([]string{
+			"TRACEPARENT=00-0102030405060708090a0b0c0d0e0f10-0102030405060708-01",
+		}))
+	})
+
+	It("should return nothing without a span", func() {
+		// Given
+		// When
+		env := opentelemetry.Env(context.Background())
+
+		// Then
+		Expect(env).To(BeEmpty())
+	})
+})
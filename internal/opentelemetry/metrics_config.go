@@ -0,0 +1,80 @@
+package opentelemetry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	prometheusbridge "go.opentelemetry.io/contrib/bridges/prometheus"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+// InitMetrics configures the opentelemetry metrics exporters, which export the
+// metrics of the provided gatherer every period to the OTLP collector at
+// collectorAddress and as JSON lines to the file at filePath. An empty address
+// or path disables the corresponding exporter. The returned function flushes
+// and stops the exporters.
+func InitMetrics(
+	ctx context.Context, gatherer prometheus.Gatherer, collectorAddress, filePath string, period time.Duration,
+) (shutdown func(context.Context) error, err error) {
+	res, err := newResource()
+	if err != nil {
+		return nil, err
+	}
+
+	producer := prometheusbridge.NewMetricProducer(prometheusbridge.WithGatherer(gatherer))
+	opts := []sdkmetric.Option{sdkmetric.WithResource(res)}
+
+	if collectorAddress != "" {
+		exporter, err := otlpmetricgrpc.New(ctx,
+			otlpmetricgrpc.WithEndpoint(collectorAddress),
+			otlpmetricgrpc.WithInsecure(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("create OTLP metrics exporter: %w", err)
+		}
+
+		opts = append(opts, sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter,
+			sdkmetric.WithInterval(period),
+			sdkmetric.WithProducer(producer),
+		)))
+	}
+
+	var file *os.File
+
+	if filePath != "" {
+		file, err = os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("open metrics export file: %w", err)
+		}
+
+		exporter, err := stdoutmetric.New(stdoutmetric.WithEncoder(json.NewEncoder(file)))
+		if err != nil {
+			file.Close()
+
+			return nil, fmt.Errorf("create file metrics exporter: %w", err)
+		}
+
+		opts = append(opts, sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter,
+			sdkmetric.WithInterval(period),
+			sdkmetric.WithProducer(producer),
+		)))
+	}
+
+	mp := sdkmetric.NewMeterProvider(opts...)
+
+	return func(ctx context.Context) error {
+		err := mp.Shutdown(ctx)
+		if file != nil {
+			err = errors.Join(err, file.Close())
+		}
+
+		return err
+	}, nil
+}
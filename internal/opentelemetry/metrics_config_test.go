@@ -0,0 +1,51 @@
+package opentelemetry_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cri-o/cri-o/internal/opentelemetry"
+)
+
+// The actual test suite.
+var _ = t.Describe("InitMetrics", func() {
+	It("should export the gathered metrics to a file", func() {
+		// Given
+		registry := prometheus.NewRegistry()
+		counter := prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "crio_test_total",
+			Help: "Test counter",
+		})
+		registry.MustRegister(counter)
+		counter.Inc()
+
+		file := filepath.Join(t.MustTempDir("metrics"), "metrics.json")
+
+		// When
+		shutdown, err := opentelemetry.InitMetrics(context.Background(), registry, "", file, time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(shutdown(context.Background())).To(Succeed())
+
+		// Then
+		content, err := os.ReadFile(file)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring("crio_test_total"))
+	})
+
+	It("should fail if the export file cannot be opened", func() {
+		// Given
+		file := filepath.Join(t.MustTempDir("metrics"), "missing", "metrics.json")
+
+		// When
+		_, err := opentelemetry.InitMetrics(context.Background(), prometheus.NewRegistry(), "", file, time.Hour)
+
+		// Then
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,27 @@
+package opentelemetry_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/cri-o/cri-o/test/framework"
+)
+
+// TestOpenTelemetry runs the created specs.
+func TestOpenTelemetry(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunFrameworkSpecs(t, "OpenTelemetry")
+}
+
+var t *TestFramework
+
+var _ = BeforeSuite(func() {
+	t = NewTestFramework(NilFunc, NilFunc)
+	t.Setup()
+})
+
+var _ = AfterSuite(func() {
+	t.Teardown()
+})
@@ -23,21 +23,30 @@ func Tracer() trace.Tracer {
 	return tracer
 }
 
-// InitTracing configures opentelemetry exporter and tracer provider.
-func InitTracing(ctx context.Context, collectorAddress string, samplingRate int) (*sdktrace.TracerProvider, []otelgrpc.Option, error) {
-	var tp *sdktrace.TracerProvider
-
+// newResource returns the resource describing the CRI-O instance, which is
+// attached to the exported telemetry data.
+func newResource() (*resource.Resource, error) {
 	hostname, err := os.Hostname()
 	if err != nil {
-		return nil, nil, fmt.Errorf("get hostname: %w", err)
+		return nil, fmt.Errorf("get hostname: %w", err)
 	}
 
-	res := resource.NewWithAttributes(
+	return resource.NewWithAttributes(
 		semconv.SchemaURL,
 		semconv.ServiceNameKey.String(tracingServiceName),
 		semconv.HostNameKey.String(hostname),
 		semconv.ProcessPIDKey.Int64(int64(os.Getpid())),
-	)
+	), nil
+}
+
+// InitTracing configures opentelemetry exporter and tracer provider.
+func InitTracing(ctx context.Context, collectorAddress string, samplingRate int) (*sdktrace.TracerProvider, []otelgrpc.Option, error) {
+	var tp *sdktrace.TracerProvider
+
+	res, err := newResource()
+	if err != nil {
+		return nil, nil, err
+	}
 
 	exporter, err := otlptracegrpc.New(ctx,
 		otlptracegrpc.WithEndpoint(collectorAddress),
@@ -9,6 +9,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/cri-o/cri-o/internal/log"
+	"github.com/cri-o/cri-o/server/metrics"
 )
 
 const (
@@ -41,6 +42,18 @@ type Resource struct {
 	stale    bool
 	name     string
 	stage    string
+	// stageStart is the time the current stage got set.
+	stageStart time.Time
+}
+
+// finishStage records the time spent in the current stage of the resource.
+func (r *Resource) finishStage() {
+	if r.stage == "" || r.stageStart.IsZero() {
+		return
+	}
+
+	metrics.Instance().MetricResourcesStageDurationObserve(r.stage, time.Since(r.stageStart))
+	r.stageStart = time.Time{}
 }
 
 // wasPut checks that a resource has been fully defined yet.
@@ -184,6 +197,7 @@ func (rc *ResourceStore) Put(name string, resource IdentifiableCreatable, cleane
 	r.resource = resource
 	r.cleaner = cleaner
 	r.name = name
+	r.finishStage()
 
 	// now the resource is created, notify the watchers
 	for _, w := range r.watchers {
@@ -199,6 +213,10 @@ func (rc *ResourceStore) Delete(name string) {
 	rc.mutex.Lock()
 	defer rc.mutex.Unlock()
 
+	if r, ok := rc.resources[name]; ok {
+		r.finishStage()
+	}
+
 	delete(rc.resources, name)
 }
 
@@ -230,6 +248,10 @@ func (rc *ResourceStore) WatcherForResource(name string) (watcher chan struct{},
 	return watcher, r.stage
 }
 
+// SetStageForResource sets the current creation stage of the resource. The
+// time spent in the previous stage gets recorded in the stage duration metric,
+// as well as the one spent in the last stage once the resource gets put into or
+// deleted from the store.
 func (rc *ResourceStore) SetStageForResource(ctx context.Context, name, stage string) {
 	rc.mutex.Lock()
 	defer rc.mutex.Unlock()
@@ -238,14 +260,17 @@ func (rc *ResourceStore) SetStageForResource(ctx context.Context, name, stage st
 	if !ok {
 		log.Debugf(ctx, "Initializing stage for resource %s to %s", name, stage)
 		rc.resources[name] = &Resource{
-			watchers: []chan struct{}{},
-			name:     name,
-			stage:    stage,
+			watchers:   []chan struct{}{},
+			name:       name,
+			stage:      stage,
+			stageStart: time.Now(),
 		}
 
 		return
 	}
 
 	log.Debugf(ctx, "Setting stage for resource %s from %s to %s", name, r.stage, stage)
+	r.finishStage()
 	r.stage = stage
+	r.stageStart = time.Now()
 }
@@ -8,6 +8,8 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/cri-o/cri-o/internal/resourcestore"
+	"github.com/cri-o/cri-o/server/metrics"
+	"github.com/cri-o/cri-o/server/metrics/collectors"
 )
 
 var (
@@ -207,5 +209,69 @@ var _ = t.Describe("ResourceStore", func() {
 			// Then
 			Expect(stage).To(Equal(stage2))
 		})
+		It("should reset stage on delete", func() {
+			// Given
+			testStage := "test delete stage"
+			sut.SetStageForResource(ctx, testName, testStage)
+			observed := stageDurationSamples(testStage)
+
+			// When
+			sut.Delete(testName)
+			_, stage := sut.WatcherForResource(testName)
+
+			// Then
+			Expect(stage).To(Equal(resourcestore.StageUnknown))
+			Expect(stageDurationSamples(testStage)).To(Equal(observed + 1))
+		})
+		It("should observe the stage duration on stage update", func() {
+			// Given
+			stage1 := "test update stage"
+			stage2 := "test update stage2"
+			sut.SetStageForResource(ctx, testName, stage1)
+			observed1 := stageDurationSamples(stage1)
+			observed2 := stageDurationSamples(stage2)
+
+			// When
+			sut.SetStageForResource(ctx, testName, stage2)
+
+			// Then
+			Expect(stageDurationSamples(stage1)).To(Equal(observed1 + 1))
+			Expect(stageDurationSamples(stage2)).To(Equal(observed2))
+		})
+		It("should observe the last stage duration on put", func() {
+			// Given
+			testStage := "test put stage"
+			sut.SetStageForResource(ctx, testName, testStage)
+			observed := stageDurationSamples(testStage)
+
+			// When
+			Expect(sut.Put(testName, e, cleaner)).To(Succeed())
+			sut.Delete(testName)
+
+			// Then
+			Expect(stageDurationSamples(testStage)).To(Equal(observed + 1))
+		})
+		It("should not observe a stage duration without a stage", func() {
+			// Given
+			_, _ = sut.WatcherForResource(testName)
+			observed := stageDurationSamples(resourcestore.StageUnknown)
+
+			// When
+			sut.Delete(testName)
+
+			// Then
+			Expect(stageDurationSamples(resourcestore.StageUnknown)).To(Equal(observed))
+		})
 	})
 })
+
+// stageDurationSamples returns the number of observed durations of the
+// provided stage.
+func stageDurationSamples(stage string) uint64 {
+	samples := metrics.Instance().Samples(collectors.ResourcesStageDurationSeconds, map[string]string{"stage": stage})
+	if len(samples) == 0 {
+		return 0
+	}
+
+	return samples[0].GetHistogram().GetSampleCount()
+}
@@ -75,9 +75,9 @@ type RuntimeServer interface {
 	SetContainerMetadata(idOrName string, metadata *RuntimeContainerMetadata) error
 
 	// CreateContainer creates a container with the specified ID.
-	// Pointer arguments can be nil.
+	// Pointer arguments and storageOpts can be nil.
 	// All other arguments are required.
-	CreateContainer(systemContext *types.SystemContext, podName, podID, userRequestedImage string, imageID StorageImageID, containerName, containerID, metadataName string, attempt uint32, idMappingsOptions *storage.IDMappingOptions, labelOptions []string, privileged bool) (ContainerInfo, error)
+	CreateContainer(systemContext *types.SystemContext, podName, podID, userRequestedImage string, imageID StorageImageID, containerName, containerID, metadataName string, attempt uint32, idMappingsOptions *storage.IDMappingOptions, labelOptions []string, privileged bool, storageOpts map[string]string) (ContainerInfo, error)
 	// DeleteContainer deletes a container, unmounting it first if need be.
 	DeleteContainer(ctx context.Context, idOrName string) error
 
@@ -156,6 +156,8 @@ type runtimeContainerMetadataTemplate struct {
 	namespace    string // Only applicable to pods
 	attempt      uint32 // Applicable to both PodSandboxes and Containers
 	privileged   bool   // Applicable to both PodSandboxes and Containers
+	// Storage driver options for the writable layer, like its size.
+	storageOpts map[string]string // Only applicable to containers
 }
 
 func (r *runtimeService) createContainerOrPodSandbox(systemContext *types.SystemContext, containerID string, template *runtimeContainerMetadataTemplate, idMappingsOptions *storage.IDMappingOptions, labelOptions []string) (ci ContainerInfo, retErr error) {
@@ -221,8 +223,9 @@ func (r *runtimeService) createContainerOrPodSandbox(systemContext *types.System
 	}
 
 	coptions := storage.ContainerOptions{
-		LabelOpts: labelOptions,
-		Volatile:  true,
+		LabelOpts:  labelOptions,
+		Volatile:   true,
+		StorageOpt: template.storageOpts,
 	}
 	if idMappingsOptions != nil {
 		coptions.IDMappingOptions = *idMappingsOptions
@@ -376,7 +379,7 @@ func (r *runtimeService) CreatePodSandbox(systemContext *types.SystemContext, po
 	}, idMappingsOptions, labelOptions)
 }
 
-func (r *runtimeService) CreateContainer(systemContext *types.SystemContext, podName, podID, userRequestedImage string, imageID StorageImageID, containerName, containerID, metadataName string, attempt uint32, idMappingsOptions *storage.IDMappingOptions, labelOptions []string, privileged bool) (ContainerInfo, error) {
+func (r *runtimeService) CreateContainer(systemContext *types.SystemContext, podName, podID, userRequestedImage string, imageID StorageImageID, containerName, containerID, metadataName string, attempt uint32, idMappingsOptions *storage.IDMappingOptions, labelOptions []string, privileged bool, storageOpts map[string]string) (ContainerInfo, error) {
 	return r.createContainerOrPodSandbox(systemContext, containerID, &runtimeContainerMetadataTemplate{
 		podName:            podName,
 		podID:              podID,
@@ -388,6 +391,7 @@ func (r *runtimeService) CreateContainer(systemContext *types.SystemContext, pod
 		namespace:          "",
 		attempt:            attempt,
 		privileged:         privileged,
+		storageOpts:        storageOpts,
 	}, idMappingsOptions, labelOptions)
 }
 
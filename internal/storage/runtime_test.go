@@ -520,7 +520,7 @@ var _ = t.Describe("Runtime", func() {
 				info, err = sut.CreateContainer(&types.SystemContext{},
 					"podName", "podID", "imagename", imageID,
 					"containerName", "containerID", "",
-					0, nil, []string{"mountLabel"}, false,
+					0, nil, []string{"mountLabel"}, false, nil,
 				)
 			})
 
@@ -558,7 +558,7 @@ var _ = t.Describe("Runtime", func() {
 			_, err := sut.CreateContainer(&types.SystemContext{},
 				"podName", "", "imagename", imageID,
 				"containerName", "containerID", "metadataName",
-				0, nil, []string{"mountLabel"}, false,
+				0, nil, []string{"mountLabel"}, false, nil,
 			)
 
 			// Then
@@ -573,7 +573,7 @@ var _ = t.Describe("Runtime", func() {
 			_, err := sut.CreateContainer(&types.SystemContext{},
 				"", "podID", "imagename", imageID,
 				"containerName", "containerID", "metadataName",
-				0, nil, []string{"mountLabel"}, false,
+				0, nil, []string{"mountLabel"}, false, nil,
 			)
 
 			// Then
@@ -588,7 +588,7 @@ var _ = t.Describe("Runtime", func() {
 			_, err := sut.CreateContainer(&types.SystemContext{},
 				"podName", "podID", "imagename", imageID,
 				"", "containerID", "metadataName",
-				0, nil, []string{"mountLabel"}, false,
+				0, nil, []string{"mountLabel"}, false, nil,
 			)
 
 			// Then
@@ -619,7 +619,7 @@ var _ = t.Describe("Runtime", func() {
 			_, err := sut.CreateContainer(&types.SystemContext{},
 				"podName", "podID", "imagename", imageID,
 				"containerName", "containerID", "metadataName",
-				0, nil, []string{"mountLabel"}, false,
+				0, nil, []string{"mountLabel"}, false, nil,
 			)
 
 			// Then
@@ -646,7 +646,7 @@ var _ = t.Describe("Runtime", func() {
 			_, err := sut.CreateContainer(&types.SystemContext{},
 				"podName", "podID", "imagename", imageID,
 				"containerName", "containerID", "metadataName",
-				0, nil, []string{"mountLabel"}, false,
+				0, nil, []string{"mountLabel"}, false, nil,
 			)
 
 			// Then
@@ -715,7 +715,7 @@ var _ = t.Describe("Runtime", func() {
 			_, err := sut.CreateContainer(&types.SystemContext{},
 				"podName", "podID", "imagename", imageID,
 				"containerName", "containerID", "metadataName",
-				0, nil, []string{"mountLabel"}, false,
+				0, nil, []string{"mountLabel"}, false, nil,
 			)
 
 			// Then
@@ -740,7 +740,7 @@ var _ = t.Describe("Runtime", func() {
 			_, err := sut.CreateContainer(&types.SystemContext{},
 				"podName", "podID", "imagename", imageID,
 				"containerName", "containerID", "metadataName",
-				0, nil, []string{"mountLabel"}, false,
+				0, nil, []string{"mountLabel"}, false, nil,
 			)
 
 			// Then
@@ -18,6 +18,13 @@ const (
 	// DisableFIPS is used to disable FIPS mode for a pod within a FIPS-enabled Kubernetes cluster.
 	DisableFIPS = "disable-fips.crio.io"
 
+	// EphemeralStorageLimit is the size limit of the container writable layers,
+	// which is enforced if ephemeral_storage_enforcement is configured.
+	// The limit can be set for:
+	// - a specific container by using: `ephemeral-storage-limit.crio.io/<CONTAINER_NAME>`
+	// - all containers of the pod by using: `ephemeral-storage-limit.crio.io`
+	EphemeralStorageLimit = "ephemeral-storage-limit.crio.io"
+
 	// LinkLogs indicates that CRI-O should link the pod containers logs into the specified
 	// emptyDir volume.
 	LinkLogs = "link-logs.crio.io"
@@ -31,6 +38,17 @@ const (
 	// PodLinuxResources indicates the sum of container resources for this pod.
 	PodLinuxResources = "pod-linux-resources.crio.io"
 
+	// PortRanges is a comma separated list of host port ranges mapped to the
+	// same ports of the pod, for example `10000-20000/udp,5060/sctp`.
+	PortRanges = "port-ranges.crio.io"
+
+	// PortForwardUDP is a comma separated list of container ports which are
+	// forwarded as UDP instead of TCP, for example `53`. Each datagram is
+	// framed with a two byte length prefix on the port forward stream, which
+	// only clients speaking this framing, like DNS over TCP clients, can use.
+	// The listed ports cannot be forwarded as TCP anymore.
+	PortForwardUDP = "port-forward-udp.crio.io"
+
 	// SeccompNotifierAction indicates a container is allowed to use the seccomp notifier feature.
 	SeccompNotifierAction = "seccomp-notifier-action.crio.io"
 
@@ -271,12 +289,15 @@ var AllAnnotations = []string{
 	CPUShared,
 	Devices,
 	DisableFIPS,
+	EphemeralStorageLimit,
 	IRQLoadBalancing,
 	LinkLogs,
 	OCISeccompBPFHook,
 	PlatformRuntimePath,
 	PodLinuxOverhead,
 	PodLinuxResources,
+	PortRanges,
+	PortForwardUDP,
 	SeccompNotifierAction,
 	SeccompProfile,
 	ShmSize,
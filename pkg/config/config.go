@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -33,6 +34,7 @@ import (
 	"k8s.io/utils/ptr"
 	"tags.cncf.io/container-device-interface/pkg/cdi"
 
+	"github.com/cri-o/cri-o/internal/bandwidth"
 	"github.com/cri-o/cri-o/internal/config/apparmor"
 	"github.com/cri-o/cri-o/internal/config/blockio"
 	"github.com/cri-o/cri-o/internal/config/capabilities"
@@ -40,6 +42,7 @@ import (
 	"github.com/cri-o/cri-o/internal/config/cnimgr"
 	"github.com/cri-o/cri-o/internal/config/conmonmgr"
 	"github.com/cri-o/cri-o/internal/config/device"
+	"github.com/cri-o/cri-o/internal/config/netbackend"
 	"github.com/cri-o/cri-o/internal/config/node"
 	"github.com/cri-o/cri-o/internal/config/nri"
 	"github.com/cri-o/cri-o/internal/config/nsmgr"
@@ -58,12 +61,39 @@ import (
 // Defaults if none are specified.
 const (
 	defaultGRPCMaxMsgSize = 80 * 1024 * 1024
+	// defaultStreamAuditRecordingMaxSize is the default size cap of a single
+	// audit session recording.
+	defaultStreamAuditRecordingMaxSize = 1024 * 1024
 	// default minimum memory for all other runtimes.
 	defaultContainerMinMemory = 12 * 1024 * 1024 // 12 MiB
 	// defaultContainerCreateTimeout is the default timeout for container creation operations in seconds.
 	defaultContainerCreateTimeout = 240
 	// minimumContainerCreateTimeout is the minimum allowed timeout for container creation operations in seconds.
 	minimumContainerCreateTimeout = 30
+	// DefaultExecSyncMaxOutputSize is the default maximum size of the combined exec sync output CRI-O will process.
+	// It is set to the amount of logs allowed in the dockershim implementation:
+	// https://github.com/kubernetes/kubernetes/pull/82514
+	DefaultExecSyncMaxOutputSize = 16 * 1024 * 1024
+
+	// ContainerLogDriverJournald forwards container output to journald.
+	ContainerLogDriverJournald = "journald"
+
+	// ContainerLogDriverJSON writes the container output in the JSON lines
+	// format next to the kubernetes container log file.
+	ContainerLogDriverJSON = "json"
+
+	// DiskUsageMethodWalk computes the container disk usage by walking the
+	// whole container file system, including the image layers.
+	DiskUsageMethodWalk = "walk"
+
+	// DiskUsageMethodQuota reports the usage of the container writable layer
+	// only, which is read from its project quota if available.
+	DiskUsageMethodQuota = "quota"
+
+	// EphemeralStorageEnforcementQuota enforces the container ephemeral
+	// storage limits by setting a project quota on the writable layer.
+	EphemeralStorageEnforcementQuota = "quota"
+
 	// minimum memory for crun, the default runtime.
 	defaultContainerMinMemoryCrun = 500 * 1024 // 500 KiB
 	OCIBufSize                    = 8192
@@ -156,6 +186,26 @@ const (
 	// DefaultLogSizeMax is the default value for the maximum log size
 	// allowed for a container. Negative values mean that no limit is imposed.
 	DefaultLogSizeMax = -1
+
+	// DefaultHostPortReconcileInterval is the default interval for removing
+	// orphaned hostport rules.
+	DefaultHostPortReconcileInterval = 10 * time.Minute
+
+	// DefaultNetworkSetupTimeout is the default time a pod network setup may
+	// take, on top of the deadline of the request.
+	DefaultNetworkSetupTimeout = 5 * time.Minute
+
+	// DefaultNetworkTeardownTimeout is the default time a single pod network
+	// teardown attempt may take.
+	DefaultNetworkTeardownTimeout = time.Minute
+
+	// DefaultNetworkTeardownRetries is the default amount of retries of a
+	// failed pod network teardown.
+	DefaultNetworkTeardownRetries = 3
+
+	// DefaultStreamPortForwardUDPIdleTimeout is the default time a UDP port
+	// forward session may stay without any datagrams.
+	DefaultStreamPortForwardUDPIdleTimeout = "1m"
 )
 
 const (
@@ -270,6 +320,11 @@ type RuntimeHandler struct {
 	//   For images, the plain annotation `seccomp-profile.crio.io`
 	//   can be used without the required `/POD` suffix or a container name.
 	// "disable-fips.crio.io" (V1: "io.kubernetes.cri-o.DisableFIPS") for disabling FIPS mode for a pod within a FIPS-enabled Kubernetes cluster.
+	// "ephemeral-storage-limit.crio.io" for limiting the writable layer size of all containers, or of a single container by using
+	//   `ephemeral-storage-limit.crio.io/<CONTAINER_NAME>`, if ephemeral_storage_enforcement is enabled.
+	// "port-ranges.crio.io" for mapping host port ranges to the same ports of the pod, for example `10000-20000/udp`.
+	// "port-forward-udp.crio.io" for forwarding a comma separated list of container ports as UDP instead of TCP, for example `53`,
+	//   using a length prefixed datagram framing only DNS over TCP style clients can use. The listed ports cannot be forwarded as TCP anymore.
 	// Both V1 and V2 annotations are accepted; V2 takes precedence when both are present.
 	// See ANNOTATION_MIGRATION.md for the complete migration guide.
 	AllowedAnnotations []string `toml:"allowed_annotations,omitempty"`
@@ -340,6 +395,21 @@ type RuntimeHandler struct {
 	// If not set, defaults to 240 seconds.
 	ContainerCreateTimeout int64 `toml:"container_create_timeout,omitempty"`
 
+	// ExecSyncMaxOutputSize is the maximum combined size in bytes of the
+	// stdout and stderr output returned by an exec sync request, for example
+	// for probes.
+	// Output exceeding this size gets truncated and ends with the
+	// ExecSyncTruncatedMessage of the oci package. The "pod" runtime type
+	// truncates the output only after receiving it from conmon-rs.
+	// If not set, defaults to 16 MiB.
+	ExecSyncMaxOutputSize int64 `toml:"exec_sync_max_output_size,omitempty"`
+
+	// ContainerLogDrivers is a list of additional log drivers the container
+	// output is forwarded to, besides the kubernetes container log file.
+	// Supported drivers are "journald" and "json", where the latter is only
+	// supported by the "pod" runtime type.
+	ContainerLogDrivers []string `toml:"container_log_drivers,omitempty"`
+
 	// seccompConfig is the seccomp configuration for the handler.
 	seccompConfig *seccomp.Config
 }
@@ -598,9 +668,29 @@ type RuntimeConfig struct {
 	// Default value is 'false'
 	DisableHostPortMapping bool `toml:"disable_hostport_mapping"`
 
+	// HostPortReconcileInterval is the interval in which installed hostport
+	// rules not belonging to any known pod sandbox get removed. The rules are
+	// always reconciled on startup, a value of 0 disables the periodic
+	// reconciliation.
+	HostPortReconcileInterval time.Duration `toml:"hostport_reconcile_interval"`
+
+	// HostPortIPv6DirectRouting reserves the host ports of IPv6 pods and only
+	// accepts the forwarded traffic to them instead of installing NAT66 rules,
+	// because the pod addresses are routed directly.
+	HostPortIPv6DirectRouting bool `toml:"hostport_ipv6_direct_routing"`
+
 	// Option to set the timezone inside the container.
 	// Use 'Local' to match the timezone of the host machine.
 	Timezone string `toml:"timezone"`
+
+	// EphemeralStorageEnforcement is the mode used to enforce the container
+	// ephemeral storage limits set by the ephemeral-storage-limit.crio.io
+	// annotation. An empty value disables the enforcement.
+	EphemeralStorageEnforcement string `toml:"ephemeral_storage_enforcement"`
+
+	// OOMForensics enables capturing the memory state of containers when
+	// they get out of memory killed.
+	OOMForensics bool `toml:"oom_forensics"`
 }
 
 // ImageConfig represents the "crio.image" TOML config table.
@@ -699,8 +789,44 @@ type NetworkConfig struct {
 	// PluginDirs is where CNI plugin binaries are stored.
 	PluginDirs []string `toml:"plugin_dirs"`
 
+	// NetworkBackendName is the backend used to set up the pod network, either
+	// "cni" or "static".
+	NetworkBackendName string `toml:"network_backend"`
+
+	// StaticNetworkSubnets are the address pools of the static network
+	// backend, at most one per IP family.
+	StaticNetworkSubnets []string `toml:"static_network_subnets"`
+
+	// StaticNetworkBridge is the bridge the pods get attached to by the static
+	// network backend.
+	StaticNetworkBridge string `toml:"static_network_bridge"`
+
+	// NetworkSetupTimeout is the time a pod network setup may take, on top of
+	// the deadline of the request.
+	NetworkSetupTimeout time.Duration `toml:"network_setup_timeout"`
+
+	// NetworkTeardownTimeout is the time a single pod network teardown
+	// attempt may take.
+	NetworkTeardownTimeout time.Duration `toml:"network_teardown_timeout"`
+
+	// NetworkTeardownRetries is the amount of times a failed pod network
+	// teardown gets retried with an exponential backoff before it is queued
+	// for being retried in the background.
+	NetworkTeardownRetries int `toml:"network_teardown_retries"`
+
+	// NetworkTeardownQueueDir is the directory where the pod network
+	// teardowns to be retried in the background are persisted.
+	NetworkTeardownQueueDir string `toml:"network_teardown_queue_dir"`
+
+	// BandwidthShaping is the way the pod bandwidth annotations are applied,
+	// either "cni" or "native".
+	BandwidthShaping string `toml:"bandwidth_shaping"`
+
 	// cniManager manages the internal ocicni plugin
 	cniManager *cnimgr.CNIManager
+
+	// networkBackend is the backend used to set up the pod network.
+	networkBackend netbackend.Backend
 }
 
 // APIConfig represents the "crio.api" TOML config table.
@@ -738,6 +864,34 @@ type APIConfig struct {
 	// StreamIdleTimeout is how long to leave idle connections open for
 	StreamIdleTimeout string `toml:"stream_idle_timeout"`
 
+	// StreamSessionIdleTimeout is how long an exec or attach session may stay
+	// without any stream activity before it gets terminated. An empty value
+	// disables the timeout.
+	StreamSessionIdleTimeout string `toml:"stream_session_idle_timeout"`
+
+	// StreamPortForwardIdleTimeout is how long a TCP port forward session may
+	// stay without any traffic before it gets terminated. An empty value
+	// disables the timeout.
+	StreamPortForwardIdleTimeout string `toml:"stream_port_forward_idle_timeout"`
+
+	// StreamPortForwardUDPIdleTimeout is how long a UDP port forward session
+	// may stay without any datagrams before it gets terminated. An empty value
+	// disables the timeout.
+	StreamPortForwardUDPIdleTimeout string `toml:"stream_port_forward_udp_idle_timeout"`
+
+	// StreamAuditLog is the audit log target for exec, attach and port forward
+	// sessions. It is either "journald" or an absolute path to a JSON lines
+	// file. An empty value disables auditing.
+	StreamAuditLog string `toml:"stream_audit_log"`
+
+	// StreamAuditRecordingDir is the directory where the terminal output of
+	// audited tty sessions gets recorded. An empty value disables recording.
+	StreamAuditRecordingDir string `toml:"stream_audit_recording_dir"`
+
+	// StreamAuditRecordingMaxSize is the maximum size in bytes of a single
+	// session recording.
+	StreamAuditRecordingMaxSize int64 `toml:"stream_audit_recording_max_size"`
+
 	// TLSMinVersion is the minimum TLS version for CRI-O's TLS servers (streaming and metrics).
 	// Valid values are: "VersionTLS12" and "VersionTLS13" (matching Kubernetes conventions).
 	// Default is "VersionTLS12".
@@ -776,8 +930,59 @@ type MetricsConfig struct {
 
 	// MetricsKey is the certificate key for the secure metrics server.
 	MetricsKey string `toml:"metrics_key"`
+
+	// MetricsPodMetrics exposes the pod sandbox metrics selected by
+	// included_pod_metrics on the metrics endpoint.
+	MetricsPodMetrics bool `toml:"metrics_pod_metrics"`
+
+	// MetricsOperationsLabels are the additional labels of the operations
+	// metrics, either "runtime_handler" or "namespace".
+	MetricsOperationsLabels []string `toml:"metrics_operations_labels"`
+
+	// MetricsOperationsNamespaces is the allowlist of namespaces reported in
+	// the namespace label of the operations metrics. If empty, every namespace
+	// is reported until the label values limit is reached.
+	MetricsOperationsNamespaces []string `toml:"metrics_operations_namespaces"`
+
+	// MetricsOperationsLabelValuesLimit is the maximum number of distinct
+	// values per additional operations label. Further values are reported as
+	// "other". A value of 0 disables the limit.
+	MetricsOperationsLabelValuesLimit int `toml:"metrics_operations_label_values_limit"`
+
+	// MetricsOTLPEndpoint is the address of the OTLP gRPC collector the
+	// enabled metrics get exported to. If empty, the metrics are not exported
+	// via OTLP.
+	MetricsOTLPEndpoint string `toml:"metrics_otlp_endpoint"`
+
+	// MetricsExportFile is the path of the file the enabled metrics get
+	// exported to as JSON lines. If empty, the metrics are not exported to a
+	// file.
+	MetricsExportFile string `toml:"metrics_export_file"`
+
+	// MetricsExportPeriod is the number of seconds between the metrics
+	// exports to the OTLP collector and the export file.
+	MetricsExportPeriod int `toml:"metrics_export_period"`
 }
 
+// Additional labels of the operations metrics.
+const (
+	// OperationsLabelRuntimeHandler is the runtime handler of the pod the
+	// operation got called for.
+	OperationsLabelRuntimeHandler = "runtime_handler"
+
+	// OperationsLabelNamespace is the Kubernetes namespace of the pod the
+	// operation got called for.
+	OperationsLabelNamespace = "namespace"
+)
+
+// OperationsLabels are the supported additional labels of the operations
+// metrics.
+var OperationsLabels = []string{OperationsLabelRuntimeHandler, OperationsLabelNamespace}
+
+// DefaultMetricsOperationsLabelValuesLimit is the default maximum number of
+// distinct values per additional operations label.
+const DefaultMetricsOperationsLabelValuesLimit = 100
+
 // TracingConfig specifies all necessary configuration for opentelemetry trace exports.
 type TracingConfig struct {
 	// EnableTracing can be used to globally enable or disable tracing support
@@ -802,11 +1007,47 @@ type StatsConfig struct {
 	// and pod sandbox metrics. If set to 0, the metrics/stats are collected on-demand instead.
 	CollectionPeriod int `toml:"collection_period"`
 
+	// StatsCacheTTL is the number of seconds collected pod/container stats and
+	// pod sandbox metrics are cached per sandbox and container. If set to a
+	// value greater than 0, the stats are collected on-demand only, and the
+	// collection period is ignored.
+	StatsCacheTTL int `toml:"stats_cache_ttl"`
+
+	// DiskUsageMethod is the method used to compute the container disk usage.
+	// It is either "walk" or "quota".
+	DiskUsageMethod string `toml:"disk_usage_method"`
+
 	// IncludedPodMetrics specifies the list of metrics to include when collecting pod metrics.
 	// If "all" is specified, all metrics are included. In that case, "all" should be the only element.
 	IncludedPodMetrics []string `toml:"included_pod_metrics"`
+
+	// PressureThresholds is the list of container pressure stall thresholds
+	// in the form <resource>_<kind>=<avg10 percentage>, like "memory_full=10".
+	PressureThresholds []string `toml:"pressure_thresholds"`
+
+	// Parsed pressure thresholds (populated during Validate)
+	pressureThresholdsParsed []PressureThreshold
+}
+
+// PressureThreshold is a container pressure stall threshold.
+type PressureThreshold struct {
+	// Resource is the stalled resource, either "cpu", "memory" or "io".
+	Resource string
+
+	// Kind is the kind of the stall, either "some" or "full".
+	Kind string
+
+	// Avg10 is the threshold of the stall percentage averaged over the last
+	// 10 seconds.
+	Avg10 float64
 }
 
+// Pressure stall resources and kinds supported by the pressure thresholds.
+var (
+	PressureResources = []string{"cpu", "memory", "io"}
+	PressureKinds     = []string{"some", "full"}
+)
+
 // tomlConfig is another way of looking at a Config, which is
 // TOML-friendly (it has all of the explicit tables). It's just used for
 // conversions.
@@ -1059,6 +1300,10 @@ func DefaultConfig() (*Config, error) {
 			GRPCMaxSendMsgSize: defaultGRPCMaxMsgSize,
 			GRPCMaxRecvMsgSize: defaultGRPCMaxMsgSize,
 			TLSMinVersion:      DefaultTLSMinVersion,
+
+			StreamAuditRecordingMaxSize: defaultStreamAuditRecordingMaxSize,
+
+			StreamPortForwardUDPIdleTimeout: DefaultStreamPortForwardUDPIdleTimeout,
 		},
 		RuntimeConfig: *DefaultRuntimeConfig(cgroupManager),
 		ImageConfig: ImageConfig{
@@ -1073,19 +1318,31 @@ func DefaultConfig() (*Config, error) {
 			NamespacedAuthDir:       cpConfig.AuthDir,
 		},
 		NetworkConfig: NetworkConfig{
-			NetworkDir: cniConfigDir,
-			PluginDirs: []string{cniBinDir},
+			NetworkDir:              cniConfigDir,
+			PluginDirs:              []string{cniBinDir},
+			NetworkBackendName:      netbackend.DefaultBackend,
+			StaticNetworkBridge:     netbackend.DefaultStaticBridge,
+			NetworkSetupTimeout:     DefaultNetworkSetupTimeout,
+			NetworkTeardownTimeout:  DefaultNetworkTeardownTimeout,
+			NetworkTeardownRetries:  DefaultNetworkTeardownRetries,
+			NetworkTeardownQueueDir: networkTeardownQueueDir,
+			BandwidthShaping:        bandwidth.ShapingCNI,
 		},
 		MetricsConfig: MetricsConfig{
-			MetricsHost:       "127.0.0.1",
-			MetricsPort:       9090,
-			MetricsCollectors: collectors.All(),
+			MetricsHost:                       "127.0.0.1",
+			MetricsPort:                       9090,
+			MetricsCollectors:                 collectors.All(),
+			MetricsOperationsLabelValuesLimit: DefaultMetricsOperationsLabelValuesLimit,
+			MetricsExportPeriod:               60,
 		},
 		TracingConfig: TracingConfig{
 			TracingEndpoint:               "127.0.0.1:4317",
 			TracingSamplingRatePerMillion: 0,
 			EnableTracing:                 false,
 		},
+		StatsConfig: StatsConfig{
+			DiskUsageMethod: DiskUsageMethodWalk,
+		},
 		NRI: nri.New(),
 	}, nil
 }
@@ -1130,6 +1387,7 @@ func DefaultRuntimeConfig(cgroupManager cgmgr.CgroupManager) *RuntimeConfig {
 		ulimitsConfig:               ulimits.New(),
 		HostNetworkDisableSELinux:   true,
 		DisableHostPortMapping:      false,
+		HostPortReconcileInterval:   DefaultHostPortReconcileInterval,
 		EnableCriuSupport:           true,
 	}
 }
@@ -1197,6 +1455,10 @@ func (c *Config) Validate(onExecution bool) error {
 		return fmt.Errorf("validating stats config: %w", err)
 	}
 
+	if err := c.MetricsConfig.Validate(); err != nil {
+		return fmt.Errorf("validating metrics config: %w", err)
+	}
+
 	return nil
 }
 
@@ -1223,6 +1485,38 @@ func (c *APIConfig) Validate(onExecution bool) error {
 		}
 	}
 
+	for _, timeout := range []struct{ option, value string }{
+		{"stream_session_idle_timeout", c.StreamSessionIdleTimeout},
+		{"stream_port_forward_idle_timeout", c.StreamPortForwardIdleTimeout},
+		{"stream_port_forward_udp_idle_timeout", c.StreamPortForwardUDPIdleTimeout},
+	} {
+		if err := validateStreamTimeout(timeout.option, timeout.value); err != nil {
+			return err
+		}
+	}
+
+	if c.StreamAuditLog != "" && c.StreamAuditLog != "journald" && !filepath.IsAbs(c.StreamAuditLog) {
+		return fmt.Errorf("stream_audit_log %q has to be either \"journald\" or an absolute path", c.StreamAuditLog)
+	}
+
+	if c.StreamAuditRecordingDir != "" {
+		if c.StreamAuditLog == "" {
+			return errors.New("stream_audit_recording_dir requires stream_audit_log to be set")
+		}
+
+		if !filepath.IsAbs(c.StreamAuditRecordingDir) {
+			return fmt.Errorf("stream_audit_recording_dir %q has to be an absolute path", c.StreamAuditRecordingDir)
+		}
+	}
+
+	if c.StreamAuditRecordingMaxSize < 0 {
+		return errors.New("stream_audit_recording_max_size must not be negative")
+	}
+
+	if c.StreamAuditRecordingMaxSize == 0 {
+		c.StreamAuditRecordingMaxSize = defaultStreamAuditRecordingMaxSize
+	}
+
 	// Reset parsed TLS state to avoid stale values after reloads
 	c.tlsMinVersionParsed = 0
 	c.tlsCipherSuitesParsed = nil
@@ -1260,6 +1554,25 @@ func (c *APIConfig) Validate(onExecution bool) error {
 	return nil
 }
 
+// validateStreamTimeout returns an error if the provided optional stream
+// timeout option is not a valid, non-negative duration.
+func validateStreamTimeout(option, value string) error {
+	if value == "" {
+		return nil
+	}
+
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return fmt.Errorf("parsing %s: %w", option, err)
+	}
+
+	if timeout < 0 {
+		return fmt.Errorf("%s must not be negative", option)
+	}
+
+	return nil
+}
+
 // RemoveUnusedSocket first ensures that the path to the socket exists and
 // removes unused socket connections if available.
 func RemoveUnusedSocket(path string) error {
@@ -1341,6 +1654,15 @@ func (c *RuntimeConfig) Validate(systemContext *types.SystemContext, onExecution
 		}
 	}
 
+	if c.EphemeralStorageEnforcement != "" && c.EphemeralStorageEnforcement != EphemeralStorageEnforcementQuota {
+		return fmt.Errorf("invalid ephemeral_storage_enforcement %q, has to be either empty or %q",
+			c.EphemeralStorageEnforcement, EphemeralStorageEnforcementQuota)
+	}
+
+	if c.HostPortReconcileInterval < 0 {
+		return fmt.Errorf("hostport reconcile interval %s must not be negative", c.HostPortReconcileInterval)
+	}
+
 	if c.LogSizeMax >= 0 && c.LogSizeMax < OCIBufSize {
 		return fmt.Errorf("log size max should be negative or >= %d", OCIBufSize)
 	}
@@ -1533,6 +1855,7 @@ func defaultRuntimeHandler(isSystemd bool) *RuntimeHandler {
 		RuntimeType:            DefaultRuntimeType,
 		RuntimeRoot:            DefaultRuntimeRoot,
 		ContainerCreateTimeout: defaultContainerCreateTimeout,
+		ExecSyncMaxOutputSize:  DefaultExecSyncMaxOutputSize,
 		AllowedAnnotations: []string{
 			v2.OCISeccompBPFHook,
 			v2.Devices,
@@ -1734,6 +2057,10 @@ func (c *RuntimeConfig) ConmonSupportsLogGlobalSizeMax() bool {
 	return c.conmonManager.SupportsLogGlobalSizeMax()
 }
 
+func (c *RuntimeConfig) ConmonSupportsLogLabel() bool {
+	return c.conmonManager.SupportsLogLabel()
+}
+
 func validateCriuInPath() error {
 	_, err := validateExecutablePath("criu", "")
 
@@ -1845,6 +2172,47 @@ func (c *ImageConfig) ParsePauseImage() (references.RegistryImageReference, erro
 // execution checks. It returns an `error` on validation failure, otherwise
 // `nil`.
 func (c *NetworkConfig) Validate(onExecution bool) error {
+	switch c.NetworkBackendName {
+	case netbackend.CNI:
+	case netbackend.Static:
+		if _, err := c.staticNetworkConfig().Validate(); err != nil {
+			return fmt.Errorf("invalid static network configuration: %w", err)
+		}
+	default:
+		return fmt.Errorf("invalid network_backend %q, must be %q or %q", c.NetworkBackendName, netbackend.CNI, netbackend.Static)
+	}
+
+	if c.NetworkSetupTimeout <= 0 {
+		return errors.New("network_setup_timeout must be positive")
+	}
+
+	if c.NetworkTeardownTimeout <= 0 {
+		return errors.New("network_teardown_timeout must be positive")
+	}
+
+	if c.NetworkTeardownRetries < 0 {
+		return errors.New("network_teardown_retries must not be negative")
+	}
+
+	if c.NetworkTeardownQueueDir == "" {
+		return errors.New("network_teardown_queue_dir must not be empty")
+	}
+
+	if err := bandwidth.ValidateShaping(c.BandwidthShaping); err != nil {
+		return fmt.Errorf("invalid bandwidth_shaping: %w", err)
+	}
+
+	if onExecution && c.NetworkBackendName == netbackend.Static {
+		backend, err := netbackend.NewStatic(c.staticNetworkConfig())
+		if err != nil {
+			return fmt.Errorf("initialize static network backend: %w", err)
+		}
+
+		c.networkBackend = backend
+
+		return nil
+	}
+
 	if onExecution {
 		err := utils.IsDirectory(c.NetworkDir)
 		if err != nil {
@@ -1887,11 +2255,20 @@ func (c *NetworkConfig) Validate(onExecution bool) error {
 		}
 
 		c.cniManager = cniManager
+		c.networkBackend = netbackend.NewCNI(cniManager)
 	}
 
 	return nil
 }
 
+func (c *NetworkConfig) staticNetworkConfig() *netbackend.StaticConfig {
+	return &netbackend.StaticConfig{
+		Subnets:  c.StaticNetworkSubnets,
+		Bridge:   c.StaticNetworkBridge,
+		StateDir: staticNetworkStateDir,
+	}
+}
+
 // Validate checks if the whole runtime is valid.
 func (r *RuntimeHandler) Validate(name string) error {
 	if err := r.ValidateRuntimeType(name); err != nil {
@@ -1916,10 +2293,18 @@ func (r *RuntimeHandler) Validate(name string) error {
 
 	r.ValidateContainerCreateTimeout(name)
 
+	if err := r.ValidateExecSyncMaxOutputSize(name); err != nil {
+		return fmt.Errorf("exec sync max output size: %w", err)
+	}
+
 	if err := r.ValidateNoSyncLog(); err != nil {
 		return fmt.Errorf("no sync log: %w", err)
 	}
 
+	if err := r.ValidateContainerLogging(name); err != nil {
+		return fmt.Errorf("container logging: %w", err)
+	}
+
 	if err := r.ValidateWebsocketStreaming(name); err != nil {
 		return fmt.Errorf("websocket streaming: %w", err)
 	}
@@ -2072,6 +2457,42 @@ func (r *RuntimeHandler) ValidateContainerCreateTimeout(name string) {
 	}
 }
 
+// ValidateExecSyncMaxOutputSize sets the default maximum exec sync output size if not configured.
+func (r *RuntimeHandler) ValidateExecSyncMaxOutputSize(name string) error {
+	switch {
+	case r.ExecSyncMaxOutputSize == 0:
+		r.ExecSyncMaxOutputSize = DefaultExecSyncMaxOutputSize
+	case r.ExecSyncMaxOutputSize < 0:
+		return fmt.Errorf("runtime handler %q exec sync max output size cannot be negative: %d", name, r.ExecSyncMaxOutputSize)
+	}
+
+	logrus.Debugf("Runtime handler %q exec sync max output size set to %d bytes", name, r.ExecSyncMaxOutputSize)
+
+	return nil
+}
+
+// ValidateContainerLogging validates the additional log drivers of the
+// runtime handler.
+func (r *RuntimeHandler) ValidateContainerLogging(name string) error {
+	for _, driver := range r.ContainerLogDrivers {
+		switch driver {
+		case ContainerLogDriverJournald:
+		case ContainerLogDriverJSON:
+			if r.RuntimeType != RuntimeTypePod {
+				return fmt.Errorf(`only the 'runtime_type = "pod"' supports the %q container log driver (runtime %q)`, driver, name)
+			}
+		default:
+			return fmt.Errorf("unsupported container log driver %q for runtime handler %q", driver, name)
+		}
+	}
+
+	if r.RuntimeType == RuntimeTypeVM && len(r.ContainerLogDrivers) > 0 {
+		return fmt.Errorf(`the 'runtime_type = "vm"' does not support additional container log drivers (runtime %q)`, name)
+	}
+
+	return nil
+}
+
 // ValidateWebsocketStreaming can be used to verify if the runtime supports WebSocket streaming.
 func (r *RuntimeHandler) ValidateWebsocketStreaming(name string) error {
 	if r.RuntimeType != RuntimeTypePod {
@@ -2226,31 +2647,83 @@ func validateAllowedAndGenerateDisallowedAnnotations(allowed []string) (disallow
 	return disallowed, nil
 }
 
-// CNIPlugin returns the network configuration CNI plugin.
+// CNIPlugin returns the network configuration CNI plugin, or nil if the CNI
+// network backend is not used.
 func (c *NetworkConfig) CNIPlugin() ocicni.CNIPlugin {
+	if c.cniManager == nil {
+		return nil
+	}
+
 	return c.cniManager.Plugin()
 }
 
-// CNIPluginReadyOrError returns whether the cni plugin is ready.
+// NetworkBackend returns the backend used to set up the pod network.
+func (c *NetworkConfig) NetworkBackend() netbackend.Backend {
+	return c.networkBackend
+}
+
+// CNIPluginReadyOrError returns whether the cni plugin is ready. Network
+// backends other than CNI are always ready.
 func (c *NetworkConfig) CNIPluginReadyOrError() error {
+	if c.cniManager == nil {
+		return nil
+	}
+
 	return c.cniManager.ReadyOrError()
 }
 
-// CNIPluginAddWatcher returns the network configuration CNI plugin.
+// CNIDiagnostics returns a snapshot of the CNI manager state, or nil if the
+// CNI network backend is not used.
+func (c *NetworkConfig) CNIDiagnostics() *cnimgr.Diagnostics {
+	if c.cniManager == nil {
+		return nil
+	}
+
+	return c.cniManager.Diagnostics()
+}
+
+// CNIDefaultNetworkPlugins returns the plugin types of the default CNI
+// network, or nil if the CNI network backend is not used.
+func (c *NetworkConfig) CNIDefaultNetworkPlugins() []string {
+	if c.cniManager == nil {
+		return nil
+	}
+
+	return c.cniManager.DefaultNetworkPlugins()
+}
+
+// CNIPluginAddWatcher returns a channel notified once the CNI plugin is
+// ready. Network backends other than CNI are always ready.
 func (c *NetworkConfig) CNIPluginAddWatcher() chan bool {
+	if c.cniManager == nil {
+		watcher := make(chan bool, 1)
+		watcher <- true
+
+		return watcher
+	}
+
 	return c.cniManager.AddWatcher()
 }
 
 // CNIPluginGC calls the plugin's GC to clean up any resources concerned with
 // stale pods (pod other than the ones provided by validPodList). The call to
 // the plugin will be deferred until it is ready logging any errors then and
-// returning nil error here.
+// returning nil error here. Network backends other than CNI have nothing to
+// clean up here.
 func (c *Config) CNIPluginGC(ctx context.Context, validPodList cnimgr.PodNetworkLister) error {
+	if c.cniManager == nil {
+		return nil
+	}
+
 	return c.cniManager.GC(ctx, validPodList)
 }
 
 // CNIManagerShutdown shuts down the CNI Manager.
 func (c *NetworkConfig) CNIManagerShutdown() {
+	if c.cniManager == nil {
+		return
+	}
+
 	c.cniManager.Shutdown()
 }
 
@@ -2259,7 +2732,40 @@ func (c *Config) SetSingleConfigPath(singleConfigPath string) {
 	c.singleConfigPath = singleConfigPath
 }
 
+func (c *MetricsConfig) Validate() error {
+	for i, label := range c.MetricsOperationsLabels {
+		if !slices.Contains(OperationsLabels, label) {
+			return fmt.Errorf("invalid metrics_operations_labels entry %q, available labels: %v", label, OperationsLabels)
+		}
+
+		if slices.Contains(c.MetricsOperationsLabels[:i], label) {
+			return fmt.Errorf("duplicate metrics_operations_labels entry %q", label)
+		}
+	}
+
+	if c.MetricsOperationsLabelValuesLimit < 0 {
+		return fmt.Errorf("metrics_operations_label_values_limit %d must not be negative", c.MetricsOperationsLabelValuesLimit)
+	}
+
+	if (c.MetricsOTLPEndpoint != "" || c.MetricsExportFile != "") && c.MetricsExportPeriod <= 0 {
+		return fmt.Errorf("metrics_export_period %d must be greater than 0", c.MetricsExportPeriod)
+	}
+
+	return nil
+}
+
 func (c *StatsConfig) Validate() error {
+	if c.StatsCacheTTL < 0 {
+		return fmt.Errorf("stats_cache_ttl %d must not be negative", c.StatsCacheTTL)
+	}
+
+	switch c.DiskUsageMethod {
+	case DiskUsageMethodWalk, DiskUsageMethodQuota:
+	default:
+		return fmt.Errorf("invalid disk_usage_method %q, has to be either %q or %q",
+			c.DiskUsageMethod, DiskUsageMethodWalk, DiskUsageMethodQuota)
+	}
+
 	for _, metrics := range c.IncludedPodMetrics {
 		if metrics == AllMetrics && len(c.IncludedPodMetrics) != 1 {
 			return errors.New("'all' should be only one element in included_pod_metrics")
@@ -2270,9 +2776,50 @@ func (c *StatsConfig) Validate() error {
 		}
 	}
 
+	c.pressureThresholdsParsed = nil
+
+	for _, threshold := range c.PressureThresholds {
+		parsed, err := parsePressureThreshold(threshold)
+		if err != nil {
+			return fmt.Errorf("invalid pressure_thresholds entry %q: %w", threshold, err)
+		}
+
+		c.pressureThresholdsParsed = append(c.pressureThresholdsParsed, parsed)
+	}
+
 	return nil
 }
 
+// GetPressureThresholds returns the parsed pressure thresholds.
+// The value is parsed and validated during Validate().
+func (c *StatsConfig) GetPressureThresholds() []PressureThreshold {
+	return c.pressureThresholdsParsed
+}
+
+func parsePressureThreshold(threshold string) (PressureThreshold, error) {
+	key, value, ok := strings.Cut(threshold, "=")
+	if !ok {
+		return PressureThreshold{}, errors.New("expected <resource>_<kind>=<avg10 percentage>")
+	}
+
+	resource, kind, ok := strings.Cut(key, "_")
+	if !ok || !slices.Contains(PressureResources, resource) || !slices.Contains(PressureKinds, kind) {
+		return PressureThreshold{}, fmt.Errorf("unknown pressure %q, resource has to be one of %v and kind one of %v",
+			key, PressureResources, PressureKinds)
+	}
+
+	avg10, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return PressureThreshold{}, fmt.Errorf("parse percentage: %w", err)
+	}
+
+	if avg10 <= 0 || avg10 > 100 {
+		return PressureThreshold{}, fmt.Errorf("percentage %v has to be greater than 0 and at most 100", avg10)
+	}
+
+	return PressureThreshold{Resource: resource, Kind: kind, Avg10: avg10}, nil
+}
+
 // DefaultTLSMinVersion is the default minimum TLS version.
 const DefaultTLSMinVersion = "VersionTLS12"
 
@@ -7,6 +7,8 @@ import "github.com/cri-o/cri-o/utils/errdefs"
 const (
 	cniConfigDir             = "/usr/local/etc/cni/net.d/"
 	cniBinDir                = "/usr/local/libexec/cni/"
+	staticNetworkStateDir    = "/var/run/crio/static-network"
+	networkTeardownQueueDir  = "/var/db/crio/network-teardown"
 	containerExitsDir        = "/var/run/crio/exits"
 	ContainerAttachSocketDir = "/var/run/crio"
 
@@ -7,6 +7,7 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -235,6 +236,93 @@ var _ = t.Describe("Config", func() {
 			// Then
 			Expect(err).To(HaveOccurred())
 		})
+
+		It("should succeed with valid stream session idle timeout", func() {
+			// Given
+			sut = runtimeValidConfig()
+			sut.StreamSessionIdleTimeout = "30m"
+
+			// When
+			err := sut.APIConfig.Validate(false)
+
+			// Then
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail with invalid stream session idle timeout", func() {
+			// Given
+			sut = runtimeValidConfig()
+			sut.StreamSessionIdleTimeout = "forever"
+
+			// When
+			err := sut.APIConfig.Validate(false)
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should succeed with valid port forward idle timeouts", func() {
+			// Given
+			sut = runtimeValidConfig()
+			sut.StreamPortForwardIdleTimeout = "1h"
+			sut.StreamPortForwardUDPIdleTimeout = "30s"
+
+			// When
+			err := sut.APIConfig.Validate(false)
+
+			// Then
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail with invalid UDP port forward idle timeout", func() {
+			// Given
+			sut = runtimeValidConfig()
+			sut.StreamPortForwardUDPIdleTimeout = "-1s"
+
+			// When
+			err := sut.APIConfig.Validate(false)
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should succeed with journald stream audit log", func() {
+			// Given
+			sut = runtimeValidConfig()
+			sut.StreamAuditLog = "journald"
+			sut.StreamAuditRecordingMaxSize = 0
+
+			// When
+			err := sut.APIConfig.Validate(false)
+
+			// Then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sut.StreamAuditRecordingMaxSize).To(BeNumerically(">", 0))
+		})
+
+		It("should fail with relative stream audit log path", func() {
+			// Given
+			sut = runtimeValidConfig()
+			sut.StreamAuditLog = "audit.log"
+
+			// When
+			err := sut.APIConfig.Validate(false)
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail with recording dir but without stream audit log", func() {
+			// Given
+			sut = runtimeValidConfig()
+			sut.StreamAuditRecordingDir = "/var/lib/crio/audit"
+
+			// When
+			err := sut.APIConfig.Validate(false)
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
 	})
 
 	t.Describe("ValidateRuntimeConfig", func() {
@@ -444,6 +532,17 @@ var _ = t.Describe("Config", func() {
 			Expect(err).To(HaveOccurred())
 		})
 
+		It("should fail on negative hostport reconcile interval", func() {
+			// Given
+			sut.HostPortReconcileInterval = -time.Second
+
+			// When
+			err := sut.RuntimeConfig.Validate(nil, false)
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
+
 		It("should succeed without defaultRuntime set", func() {
 			// Given
 			sut.DefaultRuntime = ""
@@ -563,6 +662,17 @@ var _ = t.Describe("Config", func() {
 			Expect(err).To(HaveOccurred())
 		})
 
+		It("should fail on invalid ephemeral_storage_enforcement", func() {
+			// Given
+			sut.EphemeralStorageEnforcement = "walk"
+
+			// When
+			err := sut.RuntimeConfig.Validate(nil, false)
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
+
 		It("should inherit from .Conmon even if bogus", func() {
 			// Given
 			sut.Conmon = invalidPath
@@ -1141,6 +1251,102 @@ var _ = t.Describe("Config", func() {
 			// Then
 			Expect(err).To(HaveOccurred())
 		})
+		It("should fail on invalid network backend", func() {
+			// Given
+			sut.NetworkBackendName = "invalid"
+
+			// When
+			err := sut.NetworkConfig.Validate(false)
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail on static network backend without subnets", func() {
+			// Given
+			sut.NetworkBackendName = "static"
+			sut.StaticNetworkSubnets = []string{}
+
+			// When
+			err := sut.NetworkConfig.Validate(false)
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail on invalid network teardown timeout", func() {
+			// Given
+			sut.NetworkTeardownTimeout = 0
+
+			// When
+			err := sut.NetworkConfig.Validate(false)
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail on negative network teardown retries", func() {
+			// Given
+			sut.NetworkTeardownRetries = -1
+
+			// When
+			err := sut.NetworkConfig.Validate(false)
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail on invalid bandwidth shaping", func() {
+			// Given
+			sut.BandwidthShaping = "invalid"
+
+			// When
+			err := sut.NetworkConfig.Validate(false)
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should succeed on native bandwidth shaping", func() {
+			// Given
+			sut.BandwidthShaping = "native"
+
+			// When
+			err := sut.NetworkConfig.Validate(false)
+
+			// Then
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should succeed on static network backend with subnets", func() {
+			// Given
+			sut.NetworkBackendName = "static"
+			sut.StaticNetworkSubnets = []string{"10.88.0.0/16", "fd00:88::/64"}
+
+			// When
+			err := sut.NetworkConfig.Validate(false)
+
+			// Then
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should not require the CNI manager on static network backend", func() {
+			// Given
+			sut.NetworkBackendName = "static"
+			sut.StaticNetworkSubnets = []string{"10.88.0.0/16"}
+			Expect(sut.NetworkConfig.Validate(false)).To(Succeed())
+
+			// When
+			plugin := sut.CNIPlugin()
+			watcher := sut.CNIPluginAddWatcher()
+			err := sut.CNIPluginGC(context.Background(), nil)
+
+			// Then
+			Expect(plugin).To(BeNil())
+			Expect(<-watcher).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sut.CNIPluginReadyOrError()).To(Succeed())
+		})
 	})
 
 	t.Describe("ValidateRootConfig", func() {
@@ -1880,6 +2086,101 @@ var _ = t.Describe("Config", func() {
 		})
 	})
 
+	t.Describe("ValidateExecSyncMaxOutputSize", func() {
+		It("should set default size when not configured", func() {
+			// Given
+			handler := &config.RuntimeHandler{}
+
+			// When
+			err := handler.ValidateExecSyncMaxOutputSize("test-runtime")
+
+			// Then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(handler.ExecSyncMaxOutputSize).To(Equal(int64(config.DefaultExecSyncMaxOutputSize)))
+		})
+
+		It("should use configured size when valid", func() {
+			// Given
+			handler := &config.RuntimeHandler{
+				ExecSyncMaxOutputSize: 1024,
+			}
+
+			// When
+			err := handler.ValidateExecSyncMaxOutputSize("test-runtime")
+
+			// Then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(handler.ExecSyncMaxOutputSize).To(Equal(int64(1024)))
+		})
+
+		It("should fail with negative size", func() {
+			// Given
+			handler := &config.RuntimeHandler{
+				ExecSyncMaxOutputSize: -1,
+			}
+
+			// When
+			err := handler.ValidateExecSyncMaxOutputSize("test-runtime")
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	t.Describe("ValidateContainerLogging", func() {
+		It("should succeed without additional log drivers", func() {
+			// Given
+			handler := &config.RuntimeHandler{}
+
+			// When
+			err := handler.ValidateContainerLogging("test-runtime")
+
+			// Then
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should allow the JSON log driver for the pod runtime type", func() {
+			// Given
+			handler := &config.RuntimeHandler{
+				RuntimeType:         config.RuntimeTypePod,
+				ContainerLogDrivers: []string{config.ContainerLogDriverJSON, config.ContainerLogDriverJournald},
+			}
+
+			// When
+			err := handler.ValidateContainerLogging("test-runtime")
+
+			// Then
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should fail with the JSON log driver for the oci runtime type", func() {
+			// Given
+			handler := &config.RuntimeHandler{
+				RuntimeType:         config.DefaultRuntimeType,
+				ContainerLogDrivers: []string{config.ContainerLogDriverJSON},
+			}
+
+			// When
+			err := handler.ValidateContainerLogging("test-runtime")
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail with an unsupported log driver", func() {
+			// Given
+			handler := &config.RuntimeHandler{
+				ContainerLogDrivers: []string{"syslog"},
+			}
+
+			// When
+			err := handler.ValidateContainerLogging("test-runtime")
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	t.Describe("StatsConfig.Validate", func() {
 		It("should succeed with default config", func() {
 			// Given
@@ -1944,6 +2245,163 @@ var _ = t.Describe("Config", func() {
 			// Then
 			Expect(err).To(HaveOccurred())
 		})
+
+		It("should succeed with stats cache TTL", func() {
+			// Given
+			sut.StatsCacheTTL = 10
+
+			// When
+			err := sut.StatsConfig.Validate()
+
+			// Then
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail with negative stats cache TTL", func() {
+			// Given
+			sut.StatsCacheTTL = -1
+
+			// When
+			err := sut.StatsConfig.Validate()
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should succeed with quota disk usage method", func() {
+			// Given
+			sut.DiskUsageMethod = config.DiskUsageMethodQuota
+
+			// When
+			err := sut.StatsConfig.Validate()
+
+			// Then
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail with invalid disk usage method", func() {
+			// Given
+			sut.DiskUsageMethod = "du"
+
+			// When
+			err := sut.StatsConfig.Validate()
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should succeed with pressure thresholds", func() {
+			// Given
+			sut.PressureThresholds = []string{"memory_full=10", "cpu_some=50.5"}
+
+			// When
+			err := sut.StatsConfig.Validate()
+
+			// Then
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sut.GetPressureThresholds()).To(Equal([]config.PressureThreshold{
+				{Resource: "memory", Kind: "full", Avg10: 10},
+				{Resource: "cpu", Kind: "some", Avg10: 50.5},
+			}))
+		})
+
+		DescribeTable("should fail with invalid pressure thresholds", func(threshold string) {
+			// Given
+			sut.PressureThresholds = []string{threshold}
+
+			// When
+			err := sut.StatsConfig.Validate()
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		},
+			Entry("missing value", "memory_full"),
+			Entry("unknown resource", "disk_some=10"),
+			Entry("unknown kind", "io_partial=10"),
+			Entry("invalid percentage", "io_some=ten"),
+			Entry("zero percentage", "io_some=0"),
+			Entry("too large percentage", "io_some=101"),
+		)
+	})
+
+	t.Describe("ValidateMetricsConfig", func() {
+		It("should succeed with default config", func() {
+			// Given
+			// When
+			err := sut.MetricsConfig.Validate()
+
+			// Then
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should succeed with operations labels", func() {
+			// Given
+			sut.MetricsOperationsLabels = []string{config.OperationsLabelNamespace, config.OperationsLabelRuntimeHandler}
+			sut.MetricsOperationsNamespaces = []string{"kube-system"}
+
+			// When
+			err := sut.MetricsConfig.Validate()
+
+			// Then
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail with unknown operations label", func() {
+			// Given
+			sut.MetricsOperationsLabels = []string{"pod"}
+
+			// When
+			err := sut.MetricsConfig.Validate()
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail with duplicate operations label", func() {
+			// Given
+			sut.MetricsOperationsLabels = []string{config.OperationsLabelNamespace, config.OperationsLabelNamespace}
+
+			// When
+			err := sut.MetricsConfig.Validate()
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail with negative label values limit", func() {
+			// Given
+			sut.MetricsOperationsLabelValuesLimit = -1
+
+			// When
+			err := sut.MetricsConfig.Validate()
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should succeed with metrics exporters", func() {
+			// Given
+			sut.MetricsOTLPEndpoint = "127.0.0.1:4317"
+			sut.MetricsExportFile = "/tmp/metrics.json"
+
+			// When
+			err := sut.MetricsConfig.Validate()
+
+			// Then
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail with metrics exporter and invalid export period", func() {
+			// Given
+			sut.MetricsExportFile = "/tmp/metrics.json"
+			sut.MetricsExportPeriod = 0
+
+			// When
+			err := sut.MetricsConfig.Validate()
+
+			// Then
+			Expect(err).To(HaveOccurred())
+		})
 	})
 
 	// TLSMinVersion configuration tests
@@ -10,6 +10,7 @@ import (
 
 	"github.com/cri-o/cri-o/internal/config/cgmgr"
 	"github.com/cri-o/cri-o/internal/config/cnimgr"
+	"github.com/cri-o/cri-o/internal/config/netbackend"
 	"github.com/cri-o/cri-o/internal/config/nsmgr"
 )
 
@@ -20,9 +21,16 @@ func (c *Config) SetCNIPlugin(plugin ocicni.CNIPlugin) error {
 		c.cniManager = &cnimgr.CNIManager{}
 	}
 
+	c.networkBackend = netbackend.NewCNI(c.cniManager)
+
 	return c.cniManager.SetCNIPlugin(plugin)
 }
 
+// SetNetworkBackend sets the network backend for the Configuration.
+func (c *Config) SetNetworkBackend(backend netbackend.Backend) {
+	c.networkBackend = backend
+}
+
 // SetNamespaceManager sets the namespaceManager for the Configuration.
 func (c *Config) SetNamespaceManager(nsMgr *nsmgr.NamespaceManager) {
 	c.namespaceManager = nsMgr
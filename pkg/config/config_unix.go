@@ -6,6 +6,8 @@ package config
 const (
 	cniConfigDir             = "/etc/cni/net.d/"
 	cniBinDir                = "/opt/cni/bin/"
+	staticNetworkStateDir    = "/var/run/crio/static-network"
+	networkTeardownQueueDir  = "/var/lib/crio/network-teardown"
 	containerExitsDir        = "/var/run/crio/exits"
 	ContainerAttachSocketDir = "/var/run/crio"
 
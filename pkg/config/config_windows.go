@@ -6,6 +6,8 @@ import "github.com/cri-o/cri-o/utils/errdefs"
 const (
 	cniConfigDir             = "C:\\cni\\etc\\net.d\\"
 	cniBinDir                = "C:\\cni\\bin\\"
+	staticNetworkStateDir    = "C:\\crio\\run\\static-network\\"
+	networkTeardownQueueDir  = "C:\\crio\\lib\\network-teardown\\"
 	containerExitsDir        = "C:\\crio\\run\\exits\\"
 	ContainerAttachSocketDir = "C:\\crio\\run\\"
 
@@ -192,6 +192,36 @@ func initCrioTemplateConfig(c *Config) ([]*templateConfigValue, error) {
 			group:          crioAPIConfig,
 			isDefaultValue: simpleEqual(dc.StreamIdleTimeout, c.StreamIdleTimeout),
 		},
+		{
+			templateString: templateStringCrioAPIStreamSessionIdleTimeout,
+			group:          crioAPIConfig,
+			isDefaultValue: simpleEqual(dc.StreamSessionIdleTimeout, c.StreamSessionIdleTimeout),
+		},
+		{
+			templateString: templateStringCrioAPIStreamPortForwardIdleTimeout,
+			group:          crioAPIConfig,
+			isDefaultValue: simpleEqual(dc.StreamPortForwardIdleTimeout, c.StreamPortForwardIdleTimeout),
+		},
+		{
+			templateString: templateStringCrioAPIStreamPortForwardUDPIdleTimeout,
+			group:          crioAPIConfig,
+			isDefaultValue: simpleEqual(dc.StreamPortForwardUDPIdleTimeout, c.StreamPortForwardUDPIdleTimeout),
+		},
+		{
+			templateString: templateStringCrioAPIStreamAuditLog,
+			group:          crioAPIConfig,
+			isDefaultValue: simpleEqual(dc.StreamAuditLog, c.StreamAuditLog),
+		},
+		{
+			templateString: templateStringCrioAPIStreamAuditRecordingDir,
+			group:          crioAPIConfig,
+			isDefaultValue: simpleEqual(dc.StreamAuditRecordingDir, c.StreamAuditRecordingDir),
+		},
+		{
+			templateString: templateStringCrioAPIStreamAuditRecordingMaxSize,
+			group:          crioAPIConfig,
+			isDefaultValue: simpleEqual(dc.StreamAuditRecordingMaxSize, c.StreamAuditRecordingMaxSize),
+		},
 		{
 			templateString: templateStringCrioAPIStreamTLSCert,
 			group:          crioAPIConfig,
@@ -497,11 +527,31 @@ func initCrioTemplateConfig(c *Config) ([]*templateConfigValue, error) {
 			group:          crioRuntimeConfig,
 			isDefaultValue: simpleEqual(dc.DisableHostPortMapping, c.DisableHostPortMapping),
 		},
+		{
+			templateString: templateStringCrioRuntimeHostPortReconcileInterval,
+			group:          crioRuntimeConfig,
+			isDefaultValue: simpleEqual(dc.HostPortReconcileInterval, c.HostPortReconcileInterval),
+		},
+		{
+			templateString: templateStringCrioRuntimeHostPortIPv6DirectRouting,
+			group:          crioRuntimeConfig,
+			isDefaultValue: simpleEqual(dc.HostPortIPv6DirectRouting, c.HostPortIPv6DirectRouting),
+		},
 		{
 			templateString: templateStringCrioRuntimeTimezone,
 			group:          crioRuntimeConfig,
 			isDefaultValue: simpleEqual(dc.Timezone, c.Timezone),
 		},
+		{
+			templateString: templateStringCrioRuntimeEphemeralStorageEnforcement,
+			group:          crioRuntimeConfig,
+			isDefaultValue: simpleEqual(dc.EphemeralStorageEnforcement, c.EphemeralStorageEnforcement),
+		},
+		{
+			templateString: templateStringCrioRuntimeOOMForensics,
+			group:          crioRuntimeConfig,
+			isDefaultValue: simpleEqual(dc.OOMForensics, c.OOMForensics),
+		},
 		{
 			templateString: templateStringCrioImageDefaultTransport,
 			group:          crioImageConfig,
@@ -592,6 +642,46 @@ func initCrioTemplateConfig(c *Config) ([]*templateConfigValue, error) {
 			group:          crioNetworkConfig,
 			isDefaultValue: slices.Equal(dc.PluginDirs, c.PluginDirs),
 		},
+		{
+			templateString: templateStringCrioNetworkNetworkBackend,
+			group:          crioNetworkConfig,
+			isDefaultValue: simpleEqual(dc.NetworkBackendName, c.NetworkBackendName),
+		},
+		{
+			templateString: templateStringCrioNetworkStaticNetworkSubnets,
+			group:          crioNetworkConfig,
+			isDefaultValue: slices.Equal(dc.StaticNetworkSubnets, c.StaticNetworkSubnets),
+		},
+		{
+			templateString: templateStringCrioNetworkStaticNetworkBridge,
+			group:          crioNetworkConfig,
+			isDefaultValue: simpleEqual(dc.StaticNetworkBridge, c.StaticNetworkBridge),
+		},
+		{
+			templateString: templateStringCrioNetworkNetworkSetupTimeout,
+			group:          crioNetworkConfig,
+			isDefaultValue: simpleEqual(dc.NetworkSetupTimeout, c.NetworkSetupTimeout),
+		},
+		{
+			templateString: templateStringCrioNetworkNetworkTeardownTimeout,
+			group:          crioNetworkConfig,
+			isDefaultValue: simpleEqual(dc.NetworkTeardownTimeout, c.NetworkTeardownTimeout),
+		},
+		{
+			templateString: templateStringCrioNetworkNetworkTeardownRetries,
+			group:          crioNetworkConfig,
+			isDefaultValue: simpleEqual(dc.NetworkTeardownRetries, c.NetworkTeardownRetries),
+		},
+		{
+			templateString: templateStringCrioNetworkNetworkTeardownQueueDir,
+			group:          crioNetworkConfig,
+			isDefaultValue: simpleEqual(dc.NetworkTeardownQueueDir, c.NetworkTeardownQueueDir),
+		},
+		{
+			templateString: templateStringCrioNetworkBandwidthShaping,
+			group:          crioNetworkConfig,
+			isDefaultValue: simpleEqual(dc.BandwidthShaping, c.BandwidthShaping),
+		},
 		{
 			templateString: templateStringCrioMetricsEnableMetrics,
 			group:          crioMetricsConfig,
@@ -627,6 +717,41 @@ func initCrioTemplateConfig(c *Config) ([]*templateConfigValue, error) {
 			group:          crioMetricsConfig,
 			isDefaultValue: simpleEqual(dc.MetricsKey, c.MetricsKey),
 		},
+		{
+			templateString: templateStringCrioMetricsMetricsPodMetrics,
+			group:          crioMetricsConfig,
+			isDefaultValue: simpleEqual(dc.MetricsPodMetrics, c.MetricsPodMetrics),
+		},
+		{
+			templateString: templateStringCrioMetricsMetricsOperationsLabels,
+			group:          crioMetricsConfig,
+			isDefaultValue: slices.Equal(dc.MetricsOperationsLabels, c.MetricsOperationsLabels),
+		},
+		{
+			templateString: templateStringCrioMetricsMetricsOperationsNamespaces,
+			group:          crioMetricsConfig,
+			isDefaultValue: slices.Equal(dc.MetricsOperationsNamespaces, c.MetricsOperationsNamespaces),
+		},
+		{
+			templateString: templateStringCrioMetricsMetricsOperationsLabelValuesLimit,
+			group:          crioMetricsConfig,
+			isDefaultValue: simpleEqual(dc.MetricsOperationsLabelValuesLimit, c.MetricsOperationsLabelValuesLimit),
+		},
+		{
+			templateString: templateStringCrioMetricsMetricsOTLPEndpoint,
+			group:          crioMetricsConfig,
+			isDefaultValue: simpleEqual(dc.MetricsOTLPEndpoint, c.MetricsOTLPEndpoint),
+		},
+		{
+			templateString: templateStringCrioMetricsMetricsExportFile,
+			group:          crioMetricsConfig,
+			isDefaultValue: simpleEqual(dc.MetricsExportFile, c.MetricsExportFile),
+		},
+		{
+			templateString: templateStringCrioMetricsMetricsExportPeriod,
+			group:          crioMetricsConfig,
+			isDefaultValue: simpleEqual(dc.MetricsExportPeriod, c.MetricsExportPeriod),
+		},
 		{
 			templateString: templateStringCrioTracingEnableTracing,
 			group:          crioTracingConfig,
@@ -652,11 +777,26 @@ func initCrioTemplateConfig(c *Config) ([]*templateConfigValue, error) {
 			group:          crioStatsConfig,
 			isDefaultValue: simpleEqual(dc.CollectionPeriod, c.CollectionPeriod),
 		},
+		{
+			templateString: templateStringCrioStatsStatsCacheTTL,
+			group:          crioStatsConfig,
+			isDefaultValue: simpleEqual(dc.StatsCacheTTL, c.StatsCacheTTL),
+		},
+		{
+			templateString: templateStringCrioStatsDiskUsageMethod,
+			group:          crioStatsConfig,
+			isDefaultValue: simpleEqual(dc.DiskUsageMethod, c.DiskUsageMethod),
+		},
 		{
 			templateString: templateStringCrioStatsIncludedPodMetrics,
 			group:          crioStatsConfig,
 			isDefaultValue: slices.Equal(dc.IncludedPodMetrics, c.IncludedPodMetrics),
 		},
+		{
+			templateString: templateStringCrioStatsPressureThresholds,
+			group:          crioStatsConfig,
+			isDefaultValue: slices.Equal(dc.PressureThresholds, c.PressureThresholds),
+		},
 		{
 			templateString: templateStringCrioNRIEnable,
 			group:          crioNRIConfig,
@@ -869,6 +1009,48 @@ const templateStringCrioAPIStreamIdleTimeout = `# Length of time until open stre
 
 `
 
+const templateStringCrioAPIStreamSessionIdleTimeout = `# Length of time until exec and attach sessions without any stream activity
+# get terminated. Active sessions can be listed and terminated using the
+# inspect API. An empty value disables the timeout.
+{{ $.Comment }}stream_session_idle_timeout = "{{.StreamSessionIdleTimeout}}"
+
+`
+
+const templateStringCrioAPIStreamPortForwardIdleTimeout = `# Length of time until TCP port forward sessions without any traffic get
+# terminated. An empty value disables the timeout.
+{{ $.Comment }}stream_port_forward_idle_timeout = "{{.StreamPortForwardIdleTimeout}}"
+
+`
+
+const templateStringCrioAPIStreamPortForwardUDPIdleTimeout = `# Length of time until UDP port forward sessions without any datagrams get
+# terminated. The container ports forwarded as UDP are selected by the
+# "port-forward-udp.crio.io" pod annotation. An empty value disables the
+# timeout.
+{{ $.Comment }}stream_port_forward_udp_idle_timeout = "{{.StreamPortForwardUDPIdleTimeout}}"
+
+`
+
+const templateStringCrioAPIStreamAuditLog = `# Audit log target for exec, attach and port forward sessions. Every session
+# is recorded with its container, pod, command, tty, duration and exit code.
+# Can be either "journald" or an absolute path to a JSON lines file. An empty
+# value disables auditing.
+{{ $.Comment }}stream_audit_log = "{{.StreamAuditLog}}"
+
+`
+
+const templateStringCrioAPIStreamAuditRecordingDir = `# Directory to record the terminal output of audited tty exec and attach
+# sessions into. Requires stream_audit_log to be set. An empty value disables
+# the recording.
+{{ $.Comment }}stream_audit_recording_dir = "{{.StreamAuditRecordingDir}}"
+
+`
+
+const templateStringCrioAPIStreamAuditRecordingMaxSize = `# Maximum size in bytes of a single session recording. Output exceeding the
+# limit is not recorded.
+{{ $.Comment }}stream_audit_recording_max_size = {{.StreamAuditRecordingMaxSize}}
+
+`
+
 const templateStringCrioAPIStreamTLSCert = `# Path to the x509 certificate file used to serve the encrypted stream. This
 # file can change, and CRI-O will automatically pick up the changes.
 {{ $.Comment }}stream_tls_cert = "{{ .StreamTLSCert }}"
@@ -1294,6 +1476,8 @@ const templateStringCrioRuntimeRuntimesRuntimeHandler = `# The "crio.runtime.run
 # stream_websockets = false
 # seccomp_profile = ""
 # container_create_timeout = 240
+# exec_sync_max_output_size = 16777216
+# container_log_drivers = []
 # Where:
 # - runtime-handler: Name used to identify the runtime.
 # - runtime_path (optional, string): Absolute path to the runtime executable in
@@ -1330,6 +1514,11 @@ const templateStringCrioRuntimeRuntimesRuntimeHandler = `# The "crio.runtime.run
 #     For images, the plain annotation "seccomp-profile.kubernetes.cri-o.io"
 #     can be used without the required "/POD" suffix or a container name.
 #   "io.kubernetes.cri-o.DisableFIPS" for disabling FIPS mode in a Kubernetes pod within a FIPS-enabled cluster.
+#   "ephemeral-storage-limit.crio.io" for limiting the writable layer size of all containers, or of a single container by using
+#     "ephemeral-storage-limit.crio.io/<CONTAINER_NAME>", if ephemeral_storage_enforcement is enabled.
+#   "port-ranges.crio.io" for mapping host port ranges to the same ports of the pod, for example "10000-20000/udp".
+#   "port-forward-udp.crio.io" for forwarding a comma separated list of container ports as UDP instead of TCP, for example "53",
+#     using a length prefixed datagram framing only DNS over TCP style clients can use. The listed ports cannot be forwarded as TCP anymore.
 # - monitor_path (optional, string): The path of the monitor binary. Replaces
 #   deprecated option "conmon".
 # - monitor_cgroup (optional, string): The cgroup the container monitor process will be put in.
@@ -1362,6 +1551,21 @@ const templateStringCrioRuntimeRuntimesRuntimeHandler = `# The "crio.runtime.run
 #   adjusted to 30 seconds (the minimum allowed value). This allows different runtime handlers to have
 #   different container creation timeouts, which is useful for VM-based runtimes that may need longer
 #   timeouts than OCI runtimes.
+# - exec_sync_max_output_size (optional, int64): The maximum combined size in bytes of the stdout and
+#   stderr output of exec sync requests, for example used by exec probes. Output exceeding this size is
+#   truncated, and the truncated stream ends with the marker
+#   "\n[output truncated by CRI-O: exceeded <size> bytes]\n", which is not counted against the size.
+#   Truncations are also logged and counted by the "containers_exec_sync_truncated_total" metric. For the
+#   "pod" runtime type, conmon-rs returns the whole output, which gets truncated afterwards, so the size
+#   does not bound the memory used by exec sync requests. If not set, defaults to 16 MiB.
+# - container_log_drivers (optional, array of strings): Additional log drivers the container output is
+#   forwarded to, besides the kubernetes container log file, which always keeps the CRI format read by
+#   the kubelet. The "journald" driver adds the pod name, namespace and UID as well as the container
+#   name as journal fields. For the "oci" runtime type, the fields are only added if conmon supports the
+#   --log-label option. The "json" driver is only supported by the "pod" runtime type and writes one
+#   object with the "timestamp", "pipe" and "message" keys per line to a file next to the kubernetes
+#   container log file, with the ".json" extension instead of ".log". It does not mark partial lines.
+#   Not supported by the "vm" runtime type.
 #
 # Using the seccomp notifier feature:
 #
@@ -1473,12 +1677,48 @@ const templateStringCrioRuntimeDisableHostPortMapping = `# disable_hostport_mapp
 
 `
 
+const templateStringCrioRuntimeHostPortReconcileInterval = `# The interval in which installed hostport rules not belonging to any known
+# pod sandbox get removed. The rules are always reconciled on startup, a value
+# of 0 disables the periodic reconciliation.
+{{ $.Comment }}hostport_reconcile_interval = "{{ .HostPortReconcileInterval }}"
+
+`
+
+const templateStringCrioRuntimeHostPortIPv6DirectRouting = `# If true, no NAT66 rules are installed for the hostports of IPv6 pods, which
+# have to be reachable via their directly routed address instead. Only rules
+# accepting the forwarded traffic to the pod address and host port get installed.
+# The host port of such mappings has to be equal to the container port.
+{{ $.Comment }}hostport_ipv6_direct_routing = {{ .HostPortIPv6DirectRouting }}
+
+`
+
 const templateStringCrioRuntimeTimezone = `# timezone To set the timezone for a container in CRI-O.
 # If an empty string is provided, CRI-O retains its default behavior. Use 'Local' to match the timezone of the host machine.
 {{ $.Comment }}timezone = "{{ .Timezone }}"
 
 `
 
+const templateStringCrioRuntimeEphemeralStorageEnforcement = `# The mode used to enforce the container ephemeral storage limits, which are set
+# by the "ephemeral-storage-limit.crio.io" pod annotation for all containers or
+# by "ephemeral-storage-limit.crio.io/<container name>" for a single container.
+# The annotation has to be allowed by the runtime handler. Supported values are:
+# - "": The limits are not enforced.
+# - "quota": The limit is set as project quota of the container writable
+#   layer. This requires the overlay storage driver on a file system with
+#   project quota support, like XFS mounted with "pquota".
+{{ $.Comment }}ephemeral_storage_enforcement = "{{ .EphemeralStorageEnforcement }}"
+
+`
+
+const templateStringCrioRuntimeOOMForensics = `# Capture the memory.stat, memory events, cgroup limits and the processes with
+# the highest resident set size of containers when the out of memory killer
+# kills one of their processes. The capture is stored in the run directory of
+# the container and shown in the verbose container status and the container
+# inspect API. On cgroup v1, it is only captured once the container exited.
+{{ $.Comment }}oom_forensics = {{ .OOMForensics }}
+
+`
+
 const templateStringCrioImage = `# The crio.image table contains settings pertaining to the management of OCI images.
 #
 # CRI-O reads its configured registries defaults from the system wide
@@ -1612,6 +1852,62 @@ const templateStringCrioNetworkPluginDirs = `# Paths to directories where CNI pl
 
 `
 
+const templateStringCrioNetworkNetworkBackend = `# Backend used to set up the pod network:
+# - cni: Use the CNI plugins configured in network_dir.
+# - static: Assign the pod addresses from static_network_subnets and attach
+#   the pods to static_network_bridge by using veth pairs. No CNI plugins are
+#   required.
+{{ $.Comment }}network_backend = "{{ .NetworkBackendName }}"
+
+`
+
+const templateStringCrioNetworkStaticNetworkSubnets = `# Subnets the static network backend assigns the pod addresses from, at most
+# one per IP family. The first address of each subnet is assigned to the bridge
+# and used as gateway of the pods.
+{{ $.Comment }}static_network_subnets = [
+{{ range $opt := .StaticNetworkSubnets }}{{ $.Comment }}{{ printf "\t%q,\n" $opt }}{{ end }}{{ $.Comment }}]
+
+`
+
+const templateStringCrioNetworkStaticNetworkBridge = `# Bridge the static network backend attaches the pods to. It gets created if
+# it does not exist.
+{{ $.Comment }}static_network_bridge = "{{ .StaticNetworkBridge }}"
+
+`
+
+const templateStringCrioNetworkNetworkSetupTimeout = `# The time a pod network setup may take, on top of the deadline of the
+# RunPodSandbox request.
+{{ $.Comment }}network_setup_timeout = "{{ .NetworkSetupTimeout }}"
+
+`
+
+const templateStringCrioNetworkNetworkTeardownTimeout = `# The time a single pod network teardown attempt may take.
+{{ $.Comment }}network_teardown_timeout = "{{ .NetworkTeardownTimeout }}"
+
+`
+
+const templateStringCrioNetworkNetworkTeardownRetries = `# The amount of times a failed pod network teardown gets retried with an
+# exponential backoff. If it still fails, the teardown is queued for being
+# retried in the background.
+{{ $.Comment }}network_teardown_retries = {{ .NetworkTeardownRetries }}
+
+`
+
+const templateStringCrioNetworkNetworkTeardownQueueDir = `# Path to the directory where the pod network teardowns to be retried in the
+# background are persisted.
+{{ $.Comment }}network_teardown_queue_dir = "{{ .NetworkTeardownQueueDir }}"
+
+`
+
+const templateStringCrioNetworkBandwidthShaping = `# The way the kubernetes.io/ingress-bandwidth and kubernetes.io/egress-bandwidth
+# pod annotations are applied:
+# - cni: Pass the limits to the CNI plugins, which requires the bandwidth
+#   plugin to be chained.
+# - native: Shape the traffic on the host side veth of the pod by using tc.
+{{ $.Comment }}bandwidth_shaping = "{{ .BandwidthShaping }}"
+
+`
+
 const templateStringCrioMetrics = `# A necessary configuration for Prometheus based metrics retrieval
 [crio.metrics]
 
@@ -1660,6 +1956,55 @@ const templateStringCrioMetricsMetricsKey = `# The certificate key for the secur
 
 `
 
+const templateStringCrioMetricsMetricsPodMetrics = `# Expose the pod sandbox metrics selected by included_pod_metrics on the
+# metrics endpoint as well, in addition to the ListPodSandboxMetrics CRI call.
+# The metrics get the additional "namespace", "pod" and "container" labels.
+{{ $.Comment }}metrics_pod_metrics = {{ .MetricsPodMetrics }}
+
+`
+
+const templateStringCrioMetricsMetricsOperationsLabels = `# Additional labels of the operations metrics, which break them down by the pod
+# the operation got called for. Supported labels are "runtime_handler" and
+# "namespace". Operations which do not refer to a pod get empty label values.
+{{ $.Comment }}metrics_operations_labels = [
+{{ range $opt := .MetricsOperationsLabels }}{{ $.Comment }}{{ printf "\t%q,\n" $opt }}{{ end }}{{ $.Comment }}]
+
+`
+
+const templateStringCrioMetricsMetricsOperationsNamespaces = `# Allowlist of namespaces reported in the "namespace" label of the operations
+# metrics. Operations of pods in other namespaces are reported as "other". If
+# empty, every namespace is reported until the label values limit is reached.
+{{ $.Comment }}metrics_operations_namespaces = [
+{{ range $opt := .MetricsOperationsNamespaces }}{{ $.Comment }}{{ printf "\t%q,\n" $opt }}{{ end }}{{ $.Comment }}]
+
+`
+
+const templateStringCrioMetricsMetricsOperationsLabelValuesLimit = `# Maximum number of distinct values per additional operations label. Further
+# values are reported as "other". Set to 0 to disable the limit.
+{{ $.Comment }}metrics_operations_label_values_limit = {{ .MetricsOperationsLabelValuesLimit }}
+
+`
+
+const templateStringCrioMetricsMetricsOTLPEndpoint = `# The address of the OpenTelemetry collector the enabled metrics get exported
+# to via OTLP over gRPC, like "127.0.0.1:4317". If empty, the metrics are not
+# exported via OTLP.
+{{ $.Comment }}metrics_otlp_endpoint = "{{ .MetricsOTLPEndpoint }}"
+
+`
+
+const templateStringCrioMetricsMetricsExportFile = `# The path of the file the enabled metrics get appended to as OpenTelemetry
+# JSON lines, which is mainly useful for testing. If empty, the metrics are not
+# exported to a file.
+{{ $.Comment }}metrics_export_file = "{{ .MetricsExportFile }}"
+
+`
+
+const templateStringCrioMetricsMetricsExportPeriod = `# The number of seconds between the metrics exports to the OTLP collector and
+# the export file.
+{{ $.Comment }}metrics_export_period = {{ .MetricsExportPeriod }}
+
+`
+
 const templateStringCrioTracing = `# A necessary configuration for OpenTelemetry trace data exporting
 [crio.tracing]
 
@@ -1697,13 +2042,47 @@ const templateStringCrioStatsCollectionPeriod = `# The number of seconds between
 
 `
 
+const templateStringCrioStatsStatsCacheTTL = `# The number of seconds collected pod/container stats and pod sandbox metrics
+# are cached per sandbox and container. If set to a value greater than 0, the
+# stats are collected on-demand only for the requested sandboxes and containers
+# whose cache entry expired or whose containers changed, and collection_period
+# is ignored.
+{{ $.Comment }}stats_cache_ttl = {{ .StatsCacheTTL }}
+
+`
+
+const templateStringCrioStatsDiskUsageMethod = `# The method used to compute the container disk usage. Supported values are:
+# - "walk": Walk the whole container file system, including the image layers.
+# - "quota": Report the writable layer usage only, which is read from its
+#   project quota if the overlay storage driver runs on a file system with
+#   project quota support, and computed by walking the writable layer otherwise.
+# Note that both methods report different values for the same container, as
+# "quota" does not include the image layers.
+{{ $.Comment }}disk_usage_method = "{{ .DiskUsageMethod }}"
+
+`
+
 const templateStringCrioStatsIncludedPodMetrics = `# List of included pod metrics.
 # You can also specify "all" to include all available metrics. If you specify "all", it should be the only item in the list.
+# The "network" metrics are reported per interface of the pod network namespace,
+# where the "interface" label contains the interface name. Previously, this
+# label always had the value "network".
 {{ $.Comment }}included_pod_metrics = [
 {{ range $opt := .IncludedPodMetrics }}{{ $.Comment }}{{ printf "\t%q,\n" $opt }}{{ end }}{{ $.Comment }}]
 
 `
 
+const templateStringCrioStatsPressureThresholds = `# List of container pressure stall thresholds in the form
+# <resource>_<kind>=<avg10 percentage>, where resource is one of "cpu", "memory"
+# or "io" and kind is either "some" or "full", like "memory_full=10".
+# The thresholds are checked whenever the container stats are collected. Every
+# time a container exceeds or drops below a threshold, an event is sent to the
+# clients of the pressure events inspect endpoint.
+{{ $.Comment }}pressure_thresholds = [
+{{ range $opt := .PressureThresholds }}{{ $.Comment }}{{ printf "\t%q,\n" $opt }}{{ end }}{{ $.Comment }}]
+
+`
+
 const templateStringCrioNRI = `# CRI-O NRI configuration.
 [crio.nri]
 
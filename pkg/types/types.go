@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/json"
+
 	"go.podman.io/storage/pkg/idtools"
 )
 
@@ -19,6 +21,8 @@ type ContainerInfo struct {
 	Sandbox         string            `json:"sandbox"`
 	IPs             []string          `json:"ip_addresses"`
 	HostNetwork     *bool             `json:"host_network"`
+	OOMForensics    *OOMForensics     `json:"oom_forensics,omitempty"`
+	Network         *NetworkState     `json:"network,omitempty"`
 }
 
 // IDMappings specifies the ID mappings used for containers.
@@ -35,3 +39,154 @@ type CrioInfo struct {
 	CgroupDriver      string     `json:"cgroup_driver"`
 	DefaultIDMappings IDMappings `json:"default_id_mappings"`
 }
+
+// StreamSessionInfo stores information about an active exec or attach session.
+type StreamSessionInfo struct {
+	ID           string   `json:"id"`
+	ContainerID  string   `json:"container_id"`
+	Type         string   `json:"type"`
+	Command      []string `json:"command,omitempty"`
+	TTY          bool     `json:"tty"`
+	Client       string   `json:"client,omitempty"`
+	StartedTime  int64    `json:"started_time"`
+	LastActivity int64    `json:"last_activity"`
+}
+
+// HostPortReservation stores information about a host port reserved by a
+// pod sandbox.
+type HostPortReservation struct {
+	PodSandboxID   string `json:"pod_sandbox_id"`
+	PodSandboxName string `json:"pod_sandbox_name"`
+	Family         string `json:"family"`
+	HostIP         string `json:"host_ip,omitempty"`
+	HostPort       int32  `json:"host_port"`
+	HostPortEnd    int32  `json:"host_port_end,omitempty"`
+	Protocol       string `json:"protocol"`
+}
+
+// NetworkStatus stores diagnostic information about the pod network backend.
+type NetworkStatus struct {
+	Backend        string              `json:"backend"`
+	Ready          bool                `json:"ready"`
+	Error          string              `json:"error,omitempty"`
+	LastPoll       int64               `json:"last_poll,omitempty"`
+	DefaultNetwork string              `json:"default_network,omitempty"`
+	NetworkDir     string              `json:"network_dir,omitempty"`
+	ConfigFiles    []NetworkConfigFile `json:"config_files,omitempty"`
+	PluginDirs     []NetworkPluginDir  `json:"plugin_dirs,omitempty"`
+	MissingPlugins []string            `json:"missing_plugins,omitempty"`
+	GC             *NetworkGC          `json:"gc,omitempty"`
+}
+
+// NetworkConfigFile stores information about a discovered CNI configuration
+// file.
+type NetworkConfigFile struct {
+	Path    string   `json:"path"`
+	Network string   `json:"network,omitempty"`
+	Plugins []string `json:"plugins,omitempty"`
+	Error   string   `json:"error,omitempty"`
+}
+
+// NetworkPluginDir stores the CNI plugin binaries found in a plugin directory.
+type NetworkPluginDir struct {
+	Path     string   `json:"path"`
+	Binaries []string `json:"binaries,omitempty"`
+	Error    string   `json:"error,omitempty"`
+}
+
+// NetworkGC stores the result of the last garbage collection of stale network
+// resources.
+type NetworkGC struct {
+	Time      int64  `json:"time"`
+	ValidPods int    `json:"valid_pods"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Pressure event types.
+const (
+	// PressureEventExceeded is sent when a container exceeds a pressure stall
+	// threshold.
+	PressureEventExceeded = "PRESSURE_THRESHOLD_EXCEEDED"
+
+	// PressureEventRecovered is sent when the pressure stall of a container
+	// drops below a previously exceeded threshold.
+	PressureEventRecovered = "PRESSURE_THRESHOLD_RECOVERED"
+)
+
+// PressureEvent stores information about a container crossing a configured
+// pressure stall threshold.
+type PressureEvent struct {
+	ContainerID   string  `json:"container_id"`
+	ContainerName string  `json:"container_name"`
+	PodSandboxID  string  `json:"pod_sandbox_id"`
+	EventType     string  `json:"event_type"`
+	Resource      string  `json:"resource"`
+	Kind          string  `json:"kind"`
+	Avg10         float64 `json:"avg10"`
+	Threshold     float64 `json:"threshold"`
+	CreatedAt     int64   `json:"created_at"`
+}
+
+// OOMForensics stores the memory state of a container captured at the time it
+// got out of memory killed.
+type OOMForensics struct {
+	CapturedAt   int64             `json:"captured_at"`
+	MemoryStat   map[string]uint64 `json:"memory_stat"`
+	MemoryEvents map[string]uint64 `json:"memory_events"`
+	Limits       map[string]string `json:"limits"`
+	TopProcesses []OOMProcess      `json:"top_processes"`
+}
+
+// OOMProcess stores information about a process of an out of memory killed
+// container.
+type OOMProcess struct {
+	Pid      int    `json:"pid"`
+	Command  string `json:"command"`
+	RSSBytes uint64 `json:"rss_bytes"`
+}
+
+// NetworkState stores the network of a pod sandbox as set up by the network
+// backend.
+type NetworkState struct {
+	Backend    string             `json:"backend"`
+	Network    string             `json:"network"`
+	IPs        []string           `json:"ip_addresses"`
+	Interfaces []NetworkInterface `json:"interfaces,omitempty"`
+	Routes     []NetworkRoute     `json:"routes,omitempty"`
+	DNS        *NetworkDNS        `json:"dns,omitempty"`
+	Result     json.RawMessage    `json:"result,omitempty"`
+	Bandwidth  *NetworkBandwidth  `json:"bandwidth,omitempty"`
+	CreatedAt  int64              `json:"created_at"`
+}
+
+// NetworkBandwidth stores the bandwidth limits of a pod sandbox network. The
+// rates are in bits per second and the bursts in bits.
+type NetworkBandwidth struct {
+	Shaping      string `json:"shaping"`
+	IngressRate  uint64 `json:"ingress_rate,omitempty"`
+	IngressBurst uint64 `json:"ingress_burst,omitempty"`
+	EgressRate   uint64 `json:"egress_rate,omitempty"`
+	EgressBurst  uint64 `json:"egress_burst,omitempty"`
+}
+
+// NetworkInterface stores information about an interface of a pod sandbox
+// network.
+type NetworkInterface struct {
+	Name    string `json:"name"`
+	Mac     string `json:"mac,omitempty"`
+	Sandbox string `json:"sandbox,omitempty"`
+}
+
+// NetworkRoute stores information about a route of a pod sandbox network.
+type NetworkRoute struct {
+	Destination string `json:"destination"`
+	Gateway     string `json:"gateway,omitempty"`
+}
+
+// NetworkDNS stores the DNS settings of a pod sandbox network.
+type NetworkDNS struct {
+	Nameservers []string `json:"nameservers,omitempty"`
+	Domain      string   `json:"domain,omitempty"`
+	Search      []string `json:"search,omitempty"`
+	Options     []string `json:"options,omitempty"`
+}
@@ -11,6 +11,8 @@ import (
 	"k8s.io/client-go/tools/remotecommand"
 	types "k8s.io/cri-api/pkg/apis/runtime/v1"
 
+	"github.com/cri-o/cri-o/internal/audit"
+	"github.com/cri-o/cri-o/internal/execsession"
 	"github.com/cri-o/cri-o/internal/log"
 	"github.com/cri-o/cri-o/internal/oci"
 )
@@ -41,6 +43,8 @@ func (s *Server) Attach(ctx context.Context, req *types.AttachRequest) (*types.A
 		return nil, errors.New("unable to prepare attach endpoint")
 	}
 
+	s.stream.sessions.ExpectClient(c.ID(), streamClient(ctx))
+
 	return resp, nil
 }
 
@@ -63,5 +67,22 @@ func (s *StreamService) Attach(ctx context.Context, containerID string, inputStr
 		return errors.New("container is not created or running")
 	}
 
-	return s.runtimeServer.ContainerServer.Runtime().AttachContainer(s.ctx, c, inputStream, outputStream, errorStream, tty, resizeChan)
+	sessionCtx, session := s.sessions.Register(s.ctx, c.ID(), execsession.TypeAttach, nil, tty)
+	defer s.sessions.Unregister(session)
+
+	outputStream = session.TrackWriter(outputStream)
+
+	sessionAudit := s.startSessionAudit(ctx, audit.TypeAttach, session, c, nil, tty)
+	if tty {
+		outputStream = sessionAudit.record(ctx, outputStream)
+	}
+
+	err = s.runtimeServer.ContainerServer.Runtime().AttachContainer(sessionCtx, c, session.TrackReader(inputStream), outputStream, session.TrackWriter(errorStream), tty, resizeChan)
+	if session.Terminated() {
+		err = fmt.Errorf("attach session %s terminated: %w", session.ID(), err)
+	}
+
+	sessionAudit.finish(ctx, err, false)
+
+	return err
 }
@@ -26,6 +26,7 @@ import (
 	"go.podman.io/storage/pkg/stringid"
 	"go.podman.io/storage/pkg/unshare"
 	"golang.org/x/sys/unix"
+	"k8s.io/apimachinery/pkg/api/resource"
 	types "k8s.io/cri-api/pkg/apis/runtime/v1"
 	kubeletTypes "k8s.io/kubelet/pkg/types"
 
@@ -531,6 +532,12 @@ func (s *Server) CreateContainer(ctx context.Context, req *types.CreateContainer
 		return nil
 	})
 
+	resourceCleaner.Add(ctx, "createCtr: removing container "+ctr.Name()+" from the resource store", func() error {
+		s.resourceStore.Delete(ctr.Name())
+
+		return nil
+	})
+
 	newContainer, err := s.createSandboxContainer(ctx, ctr, sb)
 	if err != nil {
 		return nil, err
@@ -919,6 +926,8 @@ func (s *Server) createSandboxContainer(ctx context.Context, ctr container.Conta
 
 	hooks := s.hooksRetriever.Get(ctx, sb.RuntimeHandler(), sb.Annotations())
 
+	s.resourceStore.SetStageForResource(ctx, ctr.Name(), "container NRI hooks")
+
 	if err := s.nri.createContainer(ctx, specgen, sb, ociContainer); err != nil {
 		return nil, err
 	}
@@ -1254,6 +1263,11 @@ func (s *Server) createStorageContainer(ctx context.Context, ctr container.Conta
 
 	metadata := ctr.Config().GetMetadata()
 
+	storageOpts, err := s.ephemeralStorageOpts(sb, metadata.GetName())
+	if err != nil {
+		return nil, nil, err
+	}
+
 	s.resourceStore.SetStageForResource(ctx, ctr.Name(), "container storage creation")
 
 	containerInfo, err := s.ContainerServer.StorageRuntimeServer().CreateContainer(s.config.SystemContext,
@@ -1265,6 +1279,7 @@ func (s *Server) createStorageContainer(ctx context.Context, ctr container.Conta
 		idMappingOptions,
 		labelOptions,
 		ctr.Privileged(),
+		storageOpts,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -1273,6 +1288,36 @@ func (s *Server) createStorageContainer(ctx context.Context, ctr container.Conta
 	return &containerInfo, containerIDMappings, nil
 }
 
+// ephemeralStorageOpts returns the storage options limiting the size of the
+// writable layer of the container, if the quota based ephemeral storage
+// enforcement is enabled and the pod requests a limit for the container.
+// The container specific annotation takes precedence over the pod wide one.
+func (s *Server) ephemeralStorageOpts(sb *sandbox.Sandbox, containerName string) (map[string]string, error) {
+	if s.config.EphemeralStorageEnforcement != config.EphemeralStorageEnforcementQuota {
+		return nil, nil
+	}
+
+	value, ok := v2.GetAnnotationValue(sb.Annotations(), v2.EphemeralStorageLimit+"/"+containerName)
+	if !ok {
+		value, ok = v2.GetAnnotationValue(sb.Annotations(), v2.EphemeralStorageLimit)
+	}
+
+	if !ok {
+		return nil, nil
+	}
+
+	limit, err := resource.ParseQuantity(value)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s annotation: %w", v2.EphemeralStorageLimit, err)
+	}
+
+	if limit.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid %s annotation %q: must be positive", v2.EphemeralStorageLimit, value)
+	}
+
+	return map[string]string{"size": strconv.FormatInt(limit.Value(), 10)}, nil
+}
+
 // resolveAndVerifyContainerImage resolves the user-requested image reference to a concrete image,
 // verifies its signature policy, and returns detailed image metadata including image ID, names, and digests.
 func (s *Server) resolveAndVerifyContainerImage(ctx context.Context, ctr container.Container, sb *sandbox.Sandbox) (*containerImageResult, error) {
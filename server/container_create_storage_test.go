@@ -0,0 +1,102 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/cri-o/cri-o/internal/lib/sandbox"
+	"github.com/cri-o/cri-o/pkg/config"
+)
+
+func TestEphemeralStorageOpts(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		enforcement string
+		annotations map[string]string
+		expected    string
+		expectErr   bool
+	}{
+		{
+			name:        "disabled enforcement",
+			enforcement: "",
+			annotations: map[string]string{"ephemeral-storage-limit.crio.io": "1Gi"},
+		},
+		{
+			name:        "no annotation",
+			enforcement: config.EphemeralStorageEnforcementQuota,
+			annotations: map[string]string{},
+		},
+		{
+			name:        "pod annotation",
+			enforcement: config.EphemeralStorageEnforcementQuota,
+			annotations: map[string]string{"ephemeral-storage-limit.crio.io": "1Gi"},
+			expected:    "1073741824",
+		},
+		{
+			name:        "container annotation",
+			enforcement: config.EphemeralStorageEnforcementQuota,
+			annotations: map[string]string{"ephemeral-storage-limit.crio.io/ctr": "100M"},
+			expected:    "100000000",
+		},
+		{
+			name:        "container annotation takes precedence",
+			enforcement: config.EphemeralStorageEnforcementQuota,
+			annotations: map[string]string{
+				"ephemeral-storage-limit.crio.io":     "1Gi",
+				"ephemeral-storage-limit.crio.io/ctr": "100M",
+			},
+			expected: "100000000",
+		},
+		{
+			name:        "annotation of another container",
+			enforcement: config.EphemeralStorageEnforcementQuota,
+			annotations: map[string]string{"ephemeral-storage-limit.crio.io/other": "100M"},
+		},
+		{
+			name:        "invalid quantity",
+			enforcement: config.EphemeralStorageEnforcementQuota,
+			annotations: map[string]string{"ephemeral-storage-limit.crio.io": "invalid"},
+			expectErr:   true,
+		},
+		{
+			name:        "zero quantity",
+			enforcement: config.EphemeralStorageEnforcementQuota,
+			annotations: map[string]string{"ephemeral-storage-limit.crio.io": "0"},
+			expectErr:   true,
+		},
+		{
+			name:        "negative quantity",
+			enforcement: config.EphemeralStorageEnforcementQuota,
+			annotations: map[string]string{"ephemeral-storage-limit.crio.io": "-1Gi"},
+			expectErr:   true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &Server{}
+			s.config.EphemeralStorageEnforcement = tc.enforcement
+
+			sb := &sandbox.Sandbox{}
+			sb.SetAnnotations(tc.annotations)
+
+			opts, err := s.ephemeralStorageOpts(sb, "ctr")
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if opts["size"] != tc.expected {
+				t.Fatalf("expected size %q, got %q", tc.expected, opts["size"])
+			}
+
+			if tc.expected == "" && opts != nil {
+				t.Fatalf("expected no storage options, got %v", opts)
+			}
+		})
+	}
+}
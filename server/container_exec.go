@@ -4,12 +4,17 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strings"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	"k8s.io/client-go/tools/remotecommand"
 	types "k8s.io/cri-api/pkg/apis/runtime/v1"
 
+	"github.com/cri-o/cri-o/internal/audit"
+	"github.com/cri-o/cri-o/internal/execsession"
 	"github.com/cri-o/cri-o/internal/log"
 )
 
@@ -39,9 +44,28 @@ func (s *Server) Exec(ctx context.Context, req *types.ExecRequest) (*types.ExecR
 		return nil, fmt.Errorf("unable to prepare exec endpoint: %w", err)
 	}
 
+	s.stream.sessions.ExpectClient(c.ID(), streamClient(ctx))
+
 	return resp, nil
 }
 
+// streamClient returns a best-effort description of the gRPC client which
+// requested a streaming endpoint, used to attribute exec and attach sessions.
+func streamClient(ctx context.Context) string {
+	client := ""
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		client = p.Addr.String()
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ua := md.Get("user-agent"); len(ua) > 0 {
+			client = strings.TrimSpace(client + " " + ua[0])
+		}
+	}
+
+	return client
+}
+
 // Exec endpoint for streaming.Runtime.
 func (s *StreamService) Exec(ctx context.Context, containerID string, cmd []string, stdin io.Reader, stdout, stderr io.WriteCloser, tty bool, resizeChan <-chan remotecommand.TerminalSize) error {
 	ctx, span := log.StartSpan(ctx)
@@ -56,5 +80,22 @@ func (s *StreamService) Exec(ctx context.Context, containerID string, cmd []stri
 		return status.Errorf(codes.NotFound, "container is not created or running: %v", err)
 	}
 
-	return s.runtimeServer.ContainerServer.Runtime().ExecContainer(s.ctx, c, cmd, stdin, stdout, stderr, tty, resizeChan)
+	sessionCtx, session := s.sessions.Register(s.ctx, c.ID(), execsession.TypeExec, cmd, tty)
+	defer s.sessions.Unregister(session)
+
+	stdout = session.TrackWriter(stdout)
+
+	sessionAudit := s.startSessionAudit(ctx, audit.TypeExec, session, c, cmd, tty)
+	if tty {
+		stdout = sessionAudit.record(ctx, stdout)
+	}
+
+	err = s.runtimeServer.ContainerServer.Runtime().ExecContainer(sessionCtx, c, cmd, session.TrackReader(stdin), stdout, session.TrackWriter(stderr), tty, resizeChan)
+	if session.Terminated() {
+		err = fmt.Errorf("exec session %s terminated: %w", session.ID(), err)
+	}
+
+	sessionAudit.finish(ctx, err, true)
+
+	return err
 }
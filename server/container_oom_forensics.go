@@ -0,0 +1,61 @@
+package server
+
+import (
+	"context"
+
+	"github.com/cri-o/cri-o/internal/lib/sandbox"
+	"github.com/cri-o/cri-o/internal/log"
+	"github.com/cri-o/cri-o/internal/oci"
+	"github.com/cri-o/cri-o/internal/oomforensics"
+)
+
+// watchOOMForensics starts capturing the OOM forensics of the running
+// container, if enabled.
+func (s *Server) watchOOMForensics(ctx context.Context, c *oci.Container, sb *sandbox.Sandbox) {
+	if s.oomForensics == nil {
+		return
+	}
+
+	memoryDir, err := s.containerMemoryCgroupDir(c, sb)
+	if err == nil {
+		err = s.oomForensics.Watch(c.ID(), memoryDir, c.Dir())
+	}
+
+	if err != nil {
+		log.Warnf(ctx, "Unable to watch container %s for OOM forensics: %v", c.ID(), err)
+	}
+}
+
+// finishOOMForensics stops capturing the OOM forensics of the stopped
+// container. If it got OOM killed without being captured yet, which is the
+// case on cgroup v1, the forensics are captured from what is left of its
+// cgroup.
+func (s *Server) finishOOMForensics(ctx context.Context, c *oci.Container, sb *sandbox.Sandbox) {
+	if s.oomForensics == nil {
+		return
+	}
+
+	s.oomForensics.Unwatch(c.ID())
+
+	if !c.State().OOMKilled || oomforensics.Exists(c.Dir()) {
+		return
+	}
+
+	memoryDir, err := s.containerMemoryCgroupDir(c, sb)
+	if err != nil {
+		log.Debugf(ctx, "Unable to capture OOM forensics of container %s: %v", c.ID(), err)
+
+		return
+	}
+
+	forensics, err := oomforensics.Capture(memoryDir)
+	if err != nil {
+		log.Debugf(ctx, "Unable to capture OOM forensics of container %s: %v", c.ID(), err)
+
+		return
+	}
+
+	if err := oomforensics.Write(c.Dir(), forensics); err != nil {
+		log.Warnf(ctx, "Unable to store OOM forensics of container %s: %v", c.ID(), err)
+	}
+}
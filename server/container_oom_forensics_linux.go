@@ -0,0 +1,20 @@
+//go:build linux
+
+package server
+
+import (
+	"github.com/cri-o/cri-o/internal/lib/sandbox"
+	"github.com/cri-o/cri-o/internal/oci"
+	"github.com/cri-o/cri-o/internal/oomforensics"
+)
+
+// containerMemoryCgroupDir returns the memory cgroup directory of the
+// container.
+func (s *Server) containerMemoryCgroupDir(c *oci.Container, sb *sandbox.Sandbox) (string, error) {
+	cgroupPath, err := s.config.CgroupManager().ContainerCgroupAbsolutePath(sb.CgroupParent(), c.ID())
+	if err != nil {
+		return "", err
+	}
+
+	return oomforensics.MemoryCgroupDir(cgroupPath), nil
+}
@@ -0,0 +1,17 @@
+//go:build !linux
+
+package server
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/cri-o/cri-o/internal/lib/sandbox"
+	"github.com/cri-o/cri-o/internal/oci"
+)
+
+// containerMemoryCgroupDir is not supported on non-Linux platforms since the
+// memory cgroup does not exist.
+func (s *Server) containerMemoryCgroupDir(*oci.Container, *sandbox.Sandbox) (string, error) {
+	return "", fmt.Errorf("OOM forensics unsupported on %s", runtime.GOOS)
+}
@@ -5,11 +5,16 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 
 	"go.podman.io/storage/pkg/pools"
+	"go.podman.io/storage/pkg/stringid"
 	types "k8s.io/cri-api/pkg/apis/runtime/v1"
 
+	"github.com/cri-o/cri-o/internal/audit"
 	"github.com/cri-o/cri-o/internal/log"
+	"github.com/cri-o/cri-o/internal/oci"
+	v2 "github.com/cri-o/cri-o/pkg/annotations/v2"
 )
 
 // PortForward prepares a streaming endpoint to forward ports from a PodSandbox.
@@ -19,6 +24,8 @@ func (s *Server) PortForward(ctx context.Context, req *types.PortForwardRequest)
 		return nil, errors.New("unable to prepare portforward endpoint")
 	}
 
+	s.stream.sessions.ExpectClient(req.GetPodSandboxId(), streamClient(ctx))
+
 	return resp, nil
 }
 
@@ -62,5 +69,23 @@ func (s *StreamService) PortForward(ctx context.Context, podSandboxID string, po
 		)
 	}
 
-	return s.runtimeServer.ContainerServer.Runtime().PortForwardContainer(ctx, sb.InfraContainer(), netNsPath, port, stream)
+	protocol := types.Protocol_TCP
+
+	if value, ok := v2.GetAnnotationValue(sb.Annotations(), v2.PortForwardUDP); ok {
+		udpPorts, err := oci.ParsePortForwardUDPPorts(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s annotation of sandbox %s: %w", v2.PortForwardUDP, sb.ID(), err)
+		}
+
+		if slices.Contains(udpPorts, port) {
+			protocol = types.Protocol_UDP
+		}
+	}
+
+	sessionAudit := s.startStreamAudit(ctx, audit.TypePortForward, stringid.GenerateNonCryptoID(), s.sessions.ClaimClient(podSandboxID), nil, sb.ID(), nil, false, port)
+
+	err = s.runtimeServer.ContainerServer.Runtime().PortForwardContainer(ctx, sb.InfraContainer(), netNsPath, port, protocol, stream)
+	sessionAudit.finish(ctx, err, false)
+
+	return err
 }
@@ -468,7 +468,7 @@ var _ = t.Describe("ContainerRestore", func() {
 					runtimeServerMock.EXPECT().CreateContainer(gomock.Any(), gomock.Any(),
 						gomock.Any(), gomock.Any(), imageID, gomock.Any(),
 						gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
-						gomock.Any(), gomock.Any()).
+						gomock.Any(), gomock.Any(), gomock.Any()).
 						Return(storage.ContainerInfo{
 							Config: &v1.Image{
 								Config: v1.ImageConfig{
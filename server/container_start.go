@@ -115,6 +115,8 @@ func (s *Server) StartContainer(ctx context.Context, req *types.StartContainerRe
 
 	s.generateCRIEvent(ctx, c, types.ContainerEventType_CONTAINER_STARTED_EVENT)
 
+	s.watchOOMForensics(ctx, c, sandbox)
+
 	if err := s.nri.postStartContainer(ctx, sandbox, c); err != nil {
 		log.Warnf(ctx, "NRI post-start failed for container %q: %v", c.ID(), err)
 	}
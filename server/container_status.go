@@ -13,7 +13,9 @@ import (
 
 	"github.com/cri-o/cri-o/internal/log"
 	oci "github.com/cri-o/cri-o/internal/oci"
+	"github.com/cri-o/cri-o/internal/oomforensics"
 	"github.com/cri-o/cri-o/internal/storage"
+	pkgtypes "github.com/cri-o/cri-o/pkg/types"
 )
 
 const (
@@ -147,10 +149,11 @@ func (s *Server) ContainerStatus(ctx context.Context, req *types.ContainerStatus
 }
 
 type containerInfo struct {
-	SandboxID   string    `json:"sandboxID"`
-	Pid         int       `json:"pid"`
-	RuntimeSpec spec.Spec `json:"runtimeSpec"`
-	Privileged  bool      `json:"privileged"`
+	SandboxID    string                 `json:"sandboxID"`
+	Pid          int                    `json:"pid"`
+	RuntimeSpec  spec.Spec              `json:"runtimeSpec"`
+	Privileged   bool                   `json:"privileged"`
+	OOMForensics *pkgtypes.OOMForensics `json:"oomForensics,omitempty"`
 }
 
 type containerInfoCheckpointRestore struct {
@@ -164,12 +167,18 @@ func (s *Server) createContainerInfo(container *oci.Container) (map[string]strin
 		return nil, fmt.Errorf("getting container metadata: %w", err)
 	}
 
+	oomForensics, err := oomforensics.Read(container.Dir())
+	if err != nil {
+		return nil, err
+	}
+
 	bytes, err := func(metadata *storage.RuntimeContainerMetadata) ([]byte, error) {
 		localContainerInfo := containerInfo{
-			SandboxID:   container.Sandbox(),
-			Pid:         container.StateNoLock().InitPid,
-			RuntimeSpec: container.Spec(),
-			Privileged:  metadata.Privileged,
+			SandboxID:    container.Sandbox(),
+			Pid:          container.StateNoLock().InitPid,
+			RuntimeSpec:  container.Spec(),
+			Privileged:   metadata.Privileged,
+			OOMForensics: oomForensics,
 		}
 
 		if s.config.CheckpointRestore() {
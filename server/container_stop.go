@@ -88,4 +88,6 @@ func (s *Server) postStopCleanup(ctx context.Context, ctr *oci.Container, sb *sa
 	if err := s.ContainerStateToDisk(ctx, ctr); err != nil {
 		log.Warnf(ctx, "Unable to write containers %s state to disk: %v", ctr.ID(), err)
 	}
+
+	s.finishOOMForensics(ctx, ctr, sb)
 }
@@ -22,6 +22,7 @@ import (
 	crierrors "k8s.io/cri-api/pkg/errors"
 
 	"github.com/cri-o/cri-o/internal/log"
+	"github.com/cri-o/cri-o/internal/opentelemetry"
 	"github.com/cri-o/cri-o/internal/storage"
 	"github.com/cri-o/cri-o/server/metrics"
 	"github.com/cri-o/cri-o/utils"
@@ -42,6 +43,7 @@ func (s *Server) PullImage(ctx context.Context, req *types.PullImageRequest) (*t
 	}
 
 	log.Infof(ctx, "Pulling image: %s", image)
+	span.SetAttributes(opentelemetry.ImageReferenceKey.String(image))
 
 	pullArgs := pullArguments{image: image}
 
@@ -297,9 +299,22 @@ func (s *Server) prepareTempAuthFile(ctx context.Context, sysCtx *imageTypes.Sys
 }
 
 func (s *Server) pullImageCandidate(ctx context.Context, sourceCtx *imageTypes.SystemContext, remoteCandidateName storage.RegistryImageReference, decryptConfig *encconfig.DecryptConfig, cgroup string) (storage.RegistryImageReference, error) {
+	ctx, span := log.StartSpan(ctx)
+	defer span.End()
+
+	span.SetAttributes(opentelemetry.ImageReferenceKey.String(remoteCandidateName.StringForOutOfProcessConsumptionOnly()))
+
 	// Collect pull progress metrics
+	var pulledBytes int64
+
 	progress := make(chan imageTypes.ProgressProperties)
-	defer close(progress)
+	progressDone := make(chan struct{})
+
+	defer func() {
+		close(progress)
+		<-progressDone
+		span.SetAttributes(opentelemetry.ImagePullBytesKey.Int64(pulledBytes))
+	}()
 
 	if deadline, ok := ctx.Deadline(); ok {
 		log.Debugf(ctx, "Pull timeout is: %s", time.Until(deadline))
@@ -307,7 +322,10 @@ func (s *Server) pullImageCandidate(ctx context.Context, sourceCtx *imageTypes.S
 
 	// Cancel the pull if no progress is made
 	pullCtx, cancel := context.WithCancel(ctx)
-	go consumeImagePullProgress(ctx, cancel, s.ContainerServer.Config().PullProgressTimeout, progress, remoteCandidateName)
+	go func() {
+		defer close(progressDone)
+		consumeImagePullProgress(ctx, cancel, s.ContainerServer.Config().PullProgressTimeout, progress, remoteCandidateName, &pulledBytes)
+	}()
 
 	repoDigest, err := s.ContainerServer.StorageImageServer().PullImage(pullCtx, remoteCandidateName, &storage.ImageCopyOptions{
 		SourceCtx:        sourceCtx,
@@ -331,10 +349,11 @@ func (s *Server) pullImageCandidate(ctx context.Context, sourceCtx *imageTypes.S
 }
 
 // consumeImagePullProgress consumes progress and turns it into metrics updates.
+// The amount of transferred bytes is accumulated in pulledBytes.
 // It also checks if progress is being made within a constant timeout.
 // If the timeout is reached because no progress updates have been made, then
 // the cancel function will be called.
-func consumeImagePullProgress(ctx context.Context, cancel context.CancelFunc, pullProgressTimeout time.Duration, progress <-chan imageTypes.ProgressProperties, remoteCandidateName storage.RegistryImageReference) {
+func consumeImagePullProgress(ctx context.Context, cancel context.CancelFunc, pullProgressTimeout time.Duration, progress <-chan imageTypes.ProgressProperties, remoteCandidateName storage.RegistryImageReference, pulledBytes *int64) {
 	timer := time.AfterFunc(pullProgressTimeout, func() {
 		if pullProgressTimeout != 0 {
 			log.Warnf(ctx, "Timed out on waiting up to %s for image pull progress updates", pullProgressTimeout)
@@ -364,6 +383,8 @@ func consumeImagePullProgress(ctx context.Context, cancel context.CancelFunc, pu
 			)
 		}
 
+		*pulledBytes += int64(p.OffsetUpdate)
+
 		// Metrics for image pulls bytes
 		metrics.Instance().MetricImagePullsBytesAdd(
 			float64(p.OffsetUpdate),
@@ -17,9 +17,11 @@ import (
 	"go.podman.io/storage/pkg/idtools"
 	"k8s.io/utils/ptr"
 
+	"github.com/cri-o/cri-o/internal/execsession"
 	"github.com/cri-o/cri-o/internal/lib/sandbox"
 	"github.com/cri-o/cri-o/internal/log"
 	"github.com/cri-o/cri-o/internal/oci"
+	"github.com/cri-o/cri-o/internal/oomforensics"
 	"github.com/cri-o/cri-o/pkg/types"
 	"github.com/cri-o/cri-o/utils"
 )
@@ -115,6 +117,11 @@ func (s *Server) getContainerInfo(ctx context.Context, id string, getContainerFu
 
 	imageRef := ctr.CRIContainer().GetImageRef()
 
+	oomForensics, err := oomforensics.Read(ctr.Dir())
+	if err != nil {
+		log.Warnf(ctx, "Unable to read OOM forensics of container %s: %v", id, err)
+	}
+
 	return types.ContainerInfo{
 		Name:            ctr.Name(),
 		Pid:             pidToReturn,
@@ -129,17 +136,109 @@ func (s *Server) getContainerInfo(ctx context.Context, id string, getContainerFu
 		Sandbox:         ctr.Sandbox(),
 		IPs:             sb.IPs(),
 		HostNetwork:     ptr.To(sb.HostNetwork()),
+		OOMForensics:    oomForensics,
+		Network:         sb.NetworkState(),
 	}, nil
 }
 
+// hostPortReservations returns the host ports currently reserved by pod
+// sandboxes.
+func (s *Server) hostPortReservations() []types.HostPortReservation {
+	reservations := s.hostportManager.Reservations()
+	res := make([]types.HostPortReservation, 0, len(reservations))
+
+	for i := range reservations {
+		res = append(res, types.HostPortReservation{
+			PodSandboxID:   reservations[i].PodID,
+			PodSandboxName: reservations[i].PodName,
+			Family:         "IPv" + string(reservations[i].Family),
+			HostIP:         reservations[i].HostIP,
+			HostPort:       reservations[i].HostPort,
+			HostPortEnd:    reservations[i].HostPortEnd,
+			Protocol:       string(reservations[i].Protocol),
+		})
+	}
+
+	return res
+}
+
+// networkStatus returns diagnostic information about the pod network backend.
+func (s *Server) networkStatus() *types.NetworkStatus {
+	status := &types.NetworkStatus{
+		Backend:        s.config.NetworkBackend().Name(),
+		Ready:          true,
+		DefaultNetwork: s.config.NetworkBackend().DefaultNetworkName(),
+	}
+
+	diag := s.config.CNIDiagnostics()
+	if diag == nil {
+		return status
+	}
+
+	if diag.ReadyError != nil {
+		status.Ready = false
+		status.Error = diag.ReadyError.Error()
+	}
+
+	if !diag.LastPoll.IsZero() {
+		status.LastPoll = diag.LastPoll.UnixNano()
+	}
+
+	status.DefaultNetwork = diag.DefaultNetwork
+	status.NetworkDir = diag.NetworkDir
+	status.MissingPlugins = diag.MissingPlugins
+
+	for _, f := range diag.ConfigFiles {
+		configFile := types.NetworkConfigFile{
+			Path:    f.Path,
+			Network: f.Network,
+			Plugins: f.Plugins,
+		}
+		if f.Error != nil {
+			configFile.Error = f.Error.Error()
+		}
+
+		status.ConfigFiles = append(status.ConfigFiles, configFile)
+	}
+
+	for _, d := range diag.PluginDirs {
+		pluginDir := types.NetworkPluginDir{
+			Path:     d.Path,
+			Binaries: d.Binaries,
+		}
+		if d.Error != nil {
+			pluginDir.Error = d.Error.Error()
+		}
+
+		status.PluginDirs = append(status.PluginDirs, pluginDir)
+	}
+
+	if !diag.LastGC.IsZero() {
+		status.GC = &types.NetworkGC{
+			Time:      diag.LastGC.UnixNano(),
+			ValidPods: diag.LastGCPods,
+		}
+		if diag.LastGCError != nil {
+			status.GC.Error = diag.LastGCError.Error()
+		}
+	}
+
+	return status
+}
+
 const (
-	InspectConfigEndpoint     = "/config"
-	InspectContainersEndpoint = "/containers"
-	InspectInfoEndpoint       = "/info"
-	InspectPauseEndpoint      = "/pause"
-	InspectUnpauseEndpoint    = "/unpause"
-	InspectGoRoutinesEndpoint = "/debug/goroutines"
-	InspectHeapEndpoint       = "/debug/heap"
+	InspectConfigEndpoint         = "/config"
+	InspectContainersEndpoint     = "/containers"
+	InspectInfoEndpoint           = "/info"
+	InspectPauseEndpoint          = "/pause"
+	InspectUnpauseEndpoint        = "/unpause"
+	InspectSessionsEndpoint       = "/sessions"
+	InspectTerminateEndpoint      = "/sessions/terminate"
+	InspectPressureEventsEndpoint = "/events/pressure"
+	InspectHostPortsEndpoint      = "/hostports"
+	InspectNetworkEndpoint        = "/network"
+	InspectGoRoutinesEndpoint     = "/debug/goroutines"
+	InspectHeapEndpoint           = "/debug/heap"
 )
 
 // GetExtendInterfaceMux returns the mux used to serve extend interface requests.
@@ -290,6 +389,107 @@ func (s *Server) GetExtendInterfaceMux(enableProfile bool) *chi.Mux {
 		}
 	}))
 
+	mux.Get(InspectSessionsEndpoint, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		js, err := json.Marshal(s.stream.sessions.List())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if _, err := w.Write(js); err != nil {
+			logrus.Errorf("Unable to write response JSON: %v", err)
+		}
+	}))
+
+	mux.Get(InspectTerminateEndpoint+"/{id}", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		sessionID := chi.URLParam(req, "id")
+
+		if err := s.stream.sessions.Terminate(s.stream.ctx, sessionID); err != nil {
+			if errors.Is(err, execsession.ErrNotFound) {
+				http.Error(w, "can't find the session with id "+sessionID, http.StatusNotFound)
+
+				return
+			}
+
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html")
+
+		if _, err := w.Write([]byte("200 OK")); err != nil {
+			logrus.Errorf("Unable to write response JSON: %v", err)
+		}
+	}))
+
+	mux.Get(InspectHostPortsEndpoint, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		js, err := json.Marshal(s.hostPortReservations())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if _, err := w.Write(js); err != nil {
+			logrus.Errorf("Unable to write response JSON: %v", err)
+		}
+	}))
+
+	mux.Get(InspectNetworkEndpoint, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		js, err := json.Marshal(s.networkStatus())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if _, err := w.Write(js); err != nil {
+			logrus.Errorf("Unable to write response JSON: %v", err)
+		}
+	}))
+
+	mux.Get(InspectPressureEventsEndpoint, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		// The events are streamed as JSON lines until the client disconnects.
+		events, cancel := s.ContainerServer.SubscribePressureEvents()
+		defer cancel()
+
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.WriteHeader(http.StatusOK)
+
+		flusher, ok := w.(http.Flusher)
+		if ok {
+			flusher.Flush()
+		}
+
+		encoder := json.NewEncoder(w)
+
+		for {
+			select {
+			case <-req.Context().Done():
+				return
+
+			case event := <-events:
+				if err := encoder.Encode(event); err != nil {
+					logrus.Errorf("Unable to write pressure event: %v", err)
+
+					return
+				}
+
+				if ok {
+					flusher.Flush()
+				}
+			}
+		}
+	}))
+
 	mux.Get(InspectGoRoutinesEndpoint, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		w.Header().Set("Content-Type", "text/plain")
 
@@ -45,6 +45,34 @@ var _ = t.Describe("Inspect", func() {
 			Expect(recorder.Code).To(BeEquivalentTo(http.StatusOK))
 		})
 
+		It("should succeed with /hostports route", func() {
+			// Given
+			// When
+			request, err := http.NewRequest(http.MethodGet, "/hostports", http.NoBody)
+			mux.ServeHTTP(recorder, request)
+
+			// Then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(recorder.Code).To(BeEquivalentTo(http.StatusOK))
+			Expect(recorder.Body.String()).To(Equal("[]"))
+		})
+
+		It("should succeed with /network route", func() {
+			// Given
+			cniPluginMock.EXPECT().GetDefaultNetworkName().Return("net").Times(2)
+
+			// When
+			request, err := http.NewRequest(http.MethodGet, "/network", http.NoBody)
+			mux.ServeHTTP(recorder, request)
+
+			// Then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(recorder.Code).To(BeEquivalentTo(http.StatusOK))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"backend":"cni"`))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"ready":true`))
+			Expect(recorder.Body.String()).To(ContainSubstring(`"default_network":"net"`))
+		})
+
 		It("should succeed with valid /containers route", func() {
 			ctx := context.TODO()
 			// Given
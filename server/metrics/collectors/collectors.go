@@ -66,6 +66,45 @@ const (
 
 	// ContainersStoppedMonitorCount is the key for the containers whose monitor is stopped per container name.
 	ContainersStoppedMonitorCount Collector = crioPrefix + "containers_stopped_monitor_count"
+
+	// ContainersExecSyncLatencySeconds is the key for the exec sync (probe) latency metrics per runtime handler.
+	ContainersExecSyncLatencySeconds Collector = crioPrefix + "containers_exec_sync_latency_seconds"
+
+	// ContainersExecSyncTruncatedTotal is the key for the total number of exec sync requests with truncated output.
+	ContainersExecSyncTruncatedTotal Collector = crioPrefix + "containers_exec_sync_truncated_total"
+
+	// StatsCollectionDurationSeconds is the key for the time spent collecting pod/container stats and pod sandbox metrics per type.
+	StatsCollectionDurationSeconds Collector = crioPrefix + "stats_collection_duration_seconds"
+
+	// StatsCacheRequestsTotal is the key for the stats cache hits and misses per type.
+	StatsCacheRequestsTotal Collector = crioPrefix + "stats_cache_requests_total"
+
+	// ContainersPressureStallEventsTotal is the key for the container pressure stall threshold crossings per resource and kind.
+	ContainersPressureStallEventsTotal Collector = crioPrefix + "containers_pressure_stall_events_total"
+
+	// ResourcesStageDurationSeconds is the key for the time spent in each stage of the pod sandbox and container creation.
+	ResourcesStageDurationSeconds Collector = crioPrefix + "resources_stage_duration_seconds"
+
+	// HostportRulesRemovedTotal is the key for the orphaned hostport rules removed per backend.
+	HostportRulesRemovedTotal Collector = crioPrefix + "hostport_rules_removed_total"
+
+	// NetworkOperationsLatencySeconds is the key for the pod network operation latency metrics per operation and plugin.
+	NetworkOperationsLatencySeconds Collector = crioPrefix + "network_operations_latency_seconds"
+
+	// NetworkOperationsErrorsTotal is the key for the failed pod network operations per operation and plugin.
+	NetworkOperationsErrorsTotal Collector = crioPrefix + "network_operations_errors_total"
+
+	// NetworkTeardownsPending is the key for the pod network teardowns queued for being retried in the background.
+	NetworkTeardownsPending Collector = crioPrefix + "network_teardowns_pending"
+
+	// PortForwardSessionsTotal is the key for the finished port forward sessions per protocol and result.
+	PortForwardSessionsTotal Collector = crioPrefix + "port_forward_sessions_total"
+
+	// PortForwardSessionDurationSeconds is the key for the port forward session durations per protocol.
+	PortForwardSessionDurationSeconds Collector = crioPrefix + "port_forward_session_duration_seconds"
+
+	// PortForwardBytesTotal is the key for the bytes forwarded by port forward sessions per protocol and direction.
+	PortForwardBytesTotal Collector = crioPrefix + "port_forward_bytes_total"
 )
 
 // FromSlice converts a string slice to a Collectors type.
@@ -107,6 +146,19 @@ func All() Collectors {
 		ContainersSeccompNotifierCountTotal.Stripped(),
 		ResourcesStalledAtStage.Stripped(),
 		ContainersStoppedMonitorCount.Stripped(),
+		ContainersExecSyncLatencySeconds.Stripped(),
+		ContainersExecSyncTruncatedTotal.Stripped(),
+		StatsCollectionDurationSeconds.Stripped(),
+		StatsCacheRequestsTotal.Stripped(),
+		ContainersPressureStallEventsTotal.Stripped(),
+		ResourcesStageDurationSeconds.Stripped(),
+		HostportRulesRemovedTotal.Stripped(),
+		NetworkOperationsLatencySeconds.Stripped(),
+		NetworkOperationsErrorsTotal.Stripped(),
+		NetworkTeardownsPending.Stripped(),
+		PortForwardSessionsTotal.Stripped(),
+		PortForwardSessionDurationSeconds.Stripped(),
+		PortForwardBytesTotal.Stripped(),
 	}
 }
 
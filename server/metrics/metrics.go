@@ -16,6 +16,7 @@ import (
 
 	"github.com/cri-o/cri-o/internal/cert"
 	"github.com/cri-o/cri-o/internal/log"
+	"github.com/cri-o/cri-o/internal/opentelemetry"
 	"github.com/cri-o/cri-o/internal/process"
 	"github.com/cri-o/cri-o/internal/storage/references"
 	libconfig "github.com/cri-o/cri-o/pkg/config"
@@ -83,15 +84,33 @@ type Metrics struct {
 	metricContainersSeccompNotifierCountTotal *prometheus.CounterVec
 	metricResourcesStalledAtStage             *prometheus.CounterVec
 	metricContainersStoppedMonitorCount       *prometheus.CounterVec
+	metricContainersExecSyncLatencySeconds    *prometheus.HistogramVec
+	metricContainersExecSyncTruncatedTotal    prometheus.Counter
+	metricStatsCollectionDurationSeconds      *prometheus.HistogramVec
+	metricStatsCacheRequestsTotal             *prometheus.CounterVec
+	metricContainersPressureStallEventsTotal  *prometheus.CounterVec
+	metricResourcesStageDuration              *prometheus.HistogramVec
+	metricHostportRulesRemovedTotal           *prometheus.CounterVec
+	metricNetworkOperationsLatencySeconds     *prometheus.HistogramVec
+	metricNetworkOperationsErrorsTotal        *prometheus.CounterVec
+	metricNetworkTeardownsPending             prometheus.Gauge
+	metricPortForwardSessionsTotal            *prometheus.CounterVec
+	metricPortForwardSessionDurationSeconds   *prometheus.HistogramVec
+	metricPortForwardBytesTotal               *prometheus.CounterVec
+	operationLabels                           *operationLabels
+	additionalCollectors                      []prometheus.Collector
 }
 
 var instance *Metrics
 
 // New creates a new metrics instance.
 func New(config *libconfig.MetricsConfig, apiConfig *libconfig.APIConfig) *Metrics {
+	operationLabels := newOperationLabels(config)
+
 	instance = &Metrics{
-		config:    config,
-		apiConfig: apiConfig,
+		config:          config,
+		apiConfig:       apiConfig,
+		operationLabels: operationLabels,
 		metricImagePullsLayerSize: prometheus.NewHistogram(
 			prometheus.HistogramOpts{
 				Subsystem: collectors.Subsystem,
@@ -149,7 +168,7 @@ func New(config *libconfig.MetricsConfig, apiConfig *libconfig.APIConfig) *Metri
 				Name:      collectors.OperationsTotal.String(),
 				Help:      "Cumulative number of CRI-O operations by operation type.",
 			},
-			[]string{"operation"},
+			operationLabels.names,
 		),
 		metricOperationsLatencySeconds: prometheus.NewGaugeVec(
 			prometheus.GaugeOpts{
@@ -157,7 +176,7 @@ func New(config *libconfig.MetricsConfig, apiConfig *libconfig.APIConfig) *Metri
 				Name:      collectors.OperationsLatencySeconds.String(),
 				Help:      "Latency in seconds of individual CRI calls for CRI-O operations. Broken down by operation type.",
 			},
-			[]string{"operation"},
+			operationLabels.names,
 		),
 		metricOperationsLatencySecondsTotal: prometheus.NewSummaryVec(
 			prometheus.SummaryOpts{
@@ -166,7 +185,7 @@ func New(config *libconfig.MetricsConfig, apiConfig *libconfig.APIConfig) *Metri
 				Help:       "Latency in seconds of CRI-O operations. Broken down by operation type.",
 				Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
 			},
-			[]string{"operation"},
+			operationLabels.names,
 		),
 		metricOperationsErrorsTotal: prometheus.NewCounterVec(
 			prometheus.CounterOpts{
@@ -174,7 +193,7 @@ func New(config *libconfig.MetricsConfig, apiConfig *libconfig.APIConfig) *Metri
 				Name:      collectors.OperationsErrorsTotal.String(),
 				Help:      "Cumulative number of CRI-O operation errors by operation type.",
 			},
-			[]string{"operation"},
+			operationLabels.names,
 		),
 		metricImagePullsBytesTotal: prometheus.NewCounterVec(
 			prometheus.CounterOpts{
@@ -250,6 +269,113 @@ func New(config *libconfig.MetricsConfig, apiConfig *libconfig.APIConfig) *Metri
 			},
 			[]string{"name"},
 		),
+		metricContainersExecSyncLatencySeconds: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Subsystem: collectors.Subsystem,
+				Name:      collectors.ContainersExecSyncLatencySeconds.String(),
+				Help:      "Latency in seconds of exec sync requests (like exec probes) by runtime handler.",
+				Buckets:   prometheus.ExponentialBuckets(0.005, 2, 14),
+			},
+			[]string{"runtime_handler"},
+		),
+		metricContainersExecSyncTruncatedTotal: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Subsystem: collectors.Subsystem,
+				Name:      collectors.ContainersExecSyncTruncatedTotal.String(),
+				Help:      "Amount of exec sync requests whose output got truncated",
+			},
+		),
+		metricStatsCollectionDurationSeconds: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Subsystem: collectors.Subsystem,
+				Name:      collectors.StatsCollectionDurationSeconds.String(),
+				Help:      "Time in seconds spent collecting the stats or metrics of a single sandbox or container by type.",
+				Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 14),
+			},
+			[]string{"type"},
+		),
+		metricStatsCacheRequestsTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Subsystem: collectors.Subsystem,
+				Name:      collectors.StatsCacheRequestsTotal.String(),
+				Help:      "Amount of stats and metrics requests per sandbox or container by type and whether they were served from the cache.",
+			},
+			[]string{"type", "result"},
+		),
+		metricContainersPressureStallEventsTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Subsystem: collectors.Subsystem,
+				Name:      collectors.ContainersPressureStallEventsTotal.String(),
+				Help:      "Amount of times a container exceeded a configured pressure stall threshold by resource and kind.",
+			},
+			[]string{"resource", "kind"},
+		),
+		metricResourcesStageDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Subsystem: collectors.Subsystem,
+				Name:      collectors.ResourcesStageDurationSeconds.String(),
+				Help:      "Time spent in each stage of the pod sandbox and container creation.",
+				Buckets:   prometheus.ExponentialBuckets(0.001, 2, 16),
+			},
+			[]string{"stage"},
+		),
+		metricHostportRulesRemovedTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Subsystem: collectors.Subsystem,
+				Name:      collectors.HostportRulesRemovedTotal.String(),
+				Help:      "Amount of orphaned hostport rules removed by the reconciler per backend.",
+			},
+			[]string{"backend"},
+		),
+		metricNetworkOperationsLatencySeconds: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Subsystem: collectors.Subsystem,
+				Name:      collectors.NetworkOperationsLatencySeconds.String(),
+				Help:      "Latency in seconds of pod network operations by operation and plugin.",
+				Buckets:   prometheus.ExponentialBuckets(0.01, 2, 14),
+			},
+			[]string{"operation", "plugin"},
+		),
+		metricNetworkOperationsErrorsTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Subsystem: collectors.Subsystem,
+				Name:      collectors.NetworkOperationsErrorsTotal.String(),
+				Help:      "Amount of failed pod network operations by operation and plugin.",
+			},
+			[]string{"operation", "plugin"},
+		),
+		metricNetworkTeardownsPending: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Subsystem: collectors.Subsystem,
+				Name:      collectors.NetworkTeardownsPending.String(),
+				Help:      "Amount of pod network teardowns queued for being retried in the background.",
+			},
+		),
+		metricPortForwardSessionsTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Subsystem: collectors.Subsystem,
+				Name:      collectors.PortForwardSessionsTotal.String(),
+				Help:      "Amount of finished port forward sessions by protocol and result.",
+			},
+			[]string{"protocol", "result"},
+		),
+		metricPortForwardSessionDurationSeconds: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Subsystem: collectors.Subsystem,
+				Name:      collectors.PortForwardSessionDurationSeconds.String(),
+				Help:      "Duration in seconds of port forward sessions by protocol.",
+				Buckets:   prometheus.ExponentialBuckets(0.1, 4, 10),
+			},
+			[]string{"protocol"},
+		),
+		metricPortForwardBytesTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Subsystem: collectors.Subsystem,
+				Name:      collectors.PortForwardBytesTotal.String(),
+				Help:      "Amount of bytes forwarded by port forward sessions by protocol and direction.",
+			},
+			[]string{"protocol", "direction"},
+		),
 	}
 
 	return Instance()
@@ -274,11 +400,16 @@ func (m *Metrics) Start(ctx context.Context, stop chan struct{}) error {
 		return errors.New("provided api config is nil")
 	}
 
-	me, err := m.createEndpoint()
-	if err != nil {
-		return fmt.Errorf("create endpoint: %w", err)
+	if err := m.register(); err != nil {
+		return fmt.Errorf("register metrics: %w", err)
+	}
+
+	if err := m.startExporters(ctx, stop); err != nil {
+		return fmt.Errorf("start exporters: %w", err)
 	}
 
+	me := m.createEndpoint()
+
 	metricsAddress := net.JoinHostPort(m.config.MetricsHost, strconv.Itoa(m.config.MetricsPort))
 	if err := m.startEndpoint(ctx, stop, "tcp", metricsAddress, me); err != nil {
 		return fmt.Errorf("create metrics endpoint on %s: %w", metricsAddress, err)
@@ -300,8 +431,14 @@ func (m *Metrics) Start(ctx context.Context, stop chan struct{}) error {
 	return nil
 }
 
-func (m *Metrics) MetricOperationsInc(operation string) {
-	c, err := m.metricOperationsTotal.GetMetricWithLabelValues(operation)
+// AddCollector adds a collector which gets registered together with the
+// built-in metrics once the endpoint gets started.
+func (m *Metrics) AddCollector(collector prometheus.Collector) {
+	m.additionalCollectors = append(m.additionalCollectors, collector)
+}
+
+func (m *Metrics) MetricOperationsInc(operation string, labels OperationLabels) {
+	c, err := m.metricOperationsTotal.GetMetricWithLabelValues(m.operationLabels.labelValues(operation, labels)...)
 	if err != nil {
 		logrus.Warnf("Unable to write operations metric: %v", err)
 
@@ -311,8 +448,8 @@ func (m *Metrics) MetricOperationsInc(operation string) {
 	c.Inc()
 }
 
-func (m *Metrics) MetricOperationsLatencySet(operation string, start time.Time) {
-	g, err := m.metricOperationsLatencySeconds.GetMetricWithLabelValues(operation)
+func (m *Metrics) MetricOperationsLatencySet(operation string, labels OperationLabels, start time.Time) {
+	g, err := m.metricOperationsLatencySeconds.GetMetricWithLabelValues(m.operationLabels.labelValues(operation, labels)...)
 	if err != nil {
 		logrus.Warnf("Unable to write operation latency metric: %v", err)
 
@@ -322,8 +459,8 @@ func (m *Metrics) MetricOperationsLatencySet(operation string, start time.Time)
 	g.Set(SinceInSeconds(start))
 }
 
-func (m *Metrics) MetricOperationsLatencyTotalObserve(operation string, start time.Time) {
-	o, err := m.metricOperationsLatencySecondsTotal.GetMetricWithLabelValues(operation)
+func (m *Metrics) MetricOperationsLatencyTotalObserve(operation string, labels OperationLabels, start time.Time) {
+	o, err := m.metricOperationsLatencySecondsTotal.GetMetricWithLabelValues(m.operationLabels.labelValues(operation, labels)...)
 	if err != nil {
 		logrus.Warnf("Unable to write operation latency (total) metric: %v", err)
 
@@ -333,8 +470,8 @@ func (m *Metrics) MetricOperationsLatencyTotalObserve(operation string, start ti
 	o.Observe(SinceInSeconds(start))
 }
 
-func (m *Metrics) MetricOperationsErrorsInc(operation string) {
-	c, err := m.metricOperationsErrorsTotal.GetMetricWithLabelValues(operation)
+func (m *Metrics) MetricOperationsErrorsInc(operation string, labels OperationLabels) {
+	c, err := m.metricOperationsErrorsTotal.GetMetricWithLabelValues(m.operationLabels.labelValues(operation, labels)...)
 	if err != nil {
 		logrus.Warnf("Unable to write operation errors metric: %v", err)
 
@@ -452,9 +589,161 @@ func (m *Metrics) MetricContainersStoppedMonitorCountInc(name string) {
 	c.Inc()
 }
 
-// createEndpoint creates a /metrics endpoint for prometheus monitoring.
-func (m *Metrics) createEndpoint() (*http.ServeMux, error) {
-	for collector, metric := range map[collectors.Collector]prometheus.Collector{
+func (m *Metrics) MetricContainersExecSyncLatencyObserve(runtimeHandler string, start time.Time) {
+	o, err := m.metricContainersExecSyncLatencySeconds.GetMetricWithLabelValues(runtimeHandler)
+	if err != nil {
+		logrus.Warnf("Unable to write container exec sync latency metric: %v", err)
+
+		return
+	}
+
+	o.Observe(SinceInSeconds(start))
+}
+
+func (m *Metrics) MetricContainersExecSyncTruncatedInc() {
+	m.metricContainersExecSyncTruncatedTotal.Inc()
+}
+
+func (m *Metrics) MetricStatsCollectionDurationObserve(statsType string, start time.Time) {
+	o, err := m.metricStatsCollectionDurationSeconds.GetMetricWithLabelValues(statsType)
+	if err != nil {
+		logrus.Warnf("Unable to write stats collection duration metric: %v", err)
+
+		return
+	}
+
+	o.Observe(SinceInSeconds(start))
+}
+
+func (m *Metrics) MetricStatsCacheRequestsInc(statsType, result string) {
+	c, err := m.metricStatsCacheRequestsTotal.GetMetricWithLabelValues(statsType, result)
+	if err != nil {
+		logrus.Warnf("Unable to write stats cache requests metric: %v", err)
+
+		return
+	}
+
+	c.Inc()
+}
+
+func (m *Metrics) MetricContainersPressureStallEventsInc(resource, kind string) {
+	c, err := m.metricContainersPressureStallEventsTotal.GetMetricWithLabelValues(resource, kind)
+	if err != nil {
+		logrus.Warnf("Unable to write container pressure stall events metric: %v", err)
+
+		return
+	}
+
+	c.Inc()
+}
+
+func (m *Metrics) MetricResourcesStageDurationObserve(stage string, duration time.Duration) {
+	o, err := m.metricResourcesStageDuration.GetMetricWithLabelValues(stage)
+	if err != nil {
+		logrus.Warnf("Unable to write resources creation stage duration metric: %v", err)
+
+		return
+	}
+
+	o.Observe(duration.Seconds())
+}
+
+func (m *Metrics) MetricHostportRulesRemovedAdd(backend string, removed int) {
+	c, err := m.metricHostportRulesRemovedTotal.GetMetricWithLabelValues(backend)
+	if err != nil {
+		logrus.Warnf("Unable to write hostport rules removed metric: %v", err)
+
+		return
+	}
+
+	c.Add(float64(removed))
+}
+
+func (m *Metrics) MetricNetworkOperationsLatencyObserve(operation, plugin string, start time.Time) {
+	o, err := m.metricNetworkOperationsLatencySeconds.GetMetricWithLabelValues(operation, plugin)
+	if err != nil {
+		logrus.Warnf("Unable to write network operations latency metric: %v", err)
+
+		return
+	}
+
+	o.Observe(SinceInSeconds(start))
+}
+
+func (m *Metrics) MetricNetworkOperationsErrorsInc(operation, plugin string) {
+	c, err := m.metricNetworkOperationsErrorsTotal.GetMetricWithLabelValues(operation, plugin)
+	if err != nil {
+		logrus.Warnf("Unable to write network operations errors metric: %v", err)
+
+		return
+	}
+
+	c.Inc()
+}
+
+func (m *Metrics) MetricNetworkTeardownsPendingSet(pending int) {
+	m.metricNetworkTeardownsPending.Set(float64(pending))
+}
+
+func (m *Metrics) MetricPortForwardSessionsInc(protocol, result string) {
+	c, err := m.metricPortForwardSessionsTotal.GetMetricWithLabelValues(protocol, result)
+	if err != nil {
+		logrus.Warnf("Unable to write port forward sessions metric: %v", err)
+
+		return
+	}
+
+	c.Inc()
+}
+
+func (m *Metrics) MetricPortForwardSessionDurationObserve(protocol string, start time.Time) {
+	o, err := m.metricPortForwardSessionDurationSeconds.GetMetricWithLabelValues(protocol)
+	if err != nil {
+		logrus.Warnf("Unable to write port forward session duration metric: %v", err)
+
+		return
+	}
+
+	o.Observe(SinceInSeconds(start))
+}
+
+func (m *Metrics) MetricPortForwardBytesAdd(protocol, direction string, bytes uint64) {
+	c, err := m.metricPortForwardBytesTotal.GetMetricWithLabelValues(protocol, direction)
+	if err != nil {
+		logrus.Warnf("Unable to write port forward bytes metric: %v", err)
+
+		return
+	}
+
+	c.Add(float64(bytes))
+}
+
+// register registers the enabled metrics to the default prometheus registry.
+func (m *Metrics) register() error {
+	for collector, metric := range m.metricCollectors() {
+		if m.config.MetricsCollectors.Contains(collector) {
+			logrus.Debugf("Enabling metric: %s", collector.Stripped())
+
+			if err := prometheus.Register(metric); err != nil {
+				return fmt.Errorf("register metric: %w", err)
+			}
+		} else {
+			logrus.Debugf("Skipping metric: %s", collector.Stripped())
+		}
+	}
+
+	for _, collector := range m.additionalCollectors {
+		if err := prometheus.Register(collector); err != nil {
+			return fmt.Errorf("register additional collector: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// metricCollectors returns the prometheus collectors of all known metrics.
+func (m *Metrics) metricCollectors() map[collectors.Collector]prometheus.Collector {
+	return map[collectors.Collector]prometheus.Collector{
 		collectors.ContainersEventsDropped:             m.metricContainersEventsDropped,
 		collectors.ContainersOOMCountTotal:             m.metricContainersOOMCountTotal,
 		collectors.ContainersOOMTotal:                  m.metricContainersOOMTotal,
@@ -472,22 +761,55 @@ func (m *Metrics) createEndpoint() (*http.ServeMux, error) {
 		collectors.ProcessesDefunct:                    m.metricProcessesDefunct,
 		collectors.ResourcesStalledAtStage:             m.metricResourcesStalledAtStage,
 		collectors.ContainersStoppedMonitorCount:       m.metricContainersStoppedMonitorCount,
-	} {
-		if m.config.MetricsCollectors.Contains(collector) {
-			logrus.Debugf("Enabling metric: %s", collector.Stripped())
+		collectors.ContainersExecSyncLatencySeconds:    m.metricContainersExecSyncLatencySeconds,
+		collectors.ContainersExecSyncTruncatedTotal:    m.metricContainersExecSyncTruncatedTotal,
+		collectors.StatsCollectionDurationSeconds:      m.metricStatsCollectionDurationSeconds,
+		collectors.StatsCacheRequestsTotal:             m.metricStatsCacheRequestsTotal,
+		collectors.ContainersPressureStallEventsTotal:  m.metricContainersPressureStallEventsTotal,
+		collectors.ResourcesStageDurationSeconds:       m.metricResourcesStageDuration,
+		collectors.HostportRulesRemovedTotal:           m.metricHostportRulesRemovedTotal,
+		collectors.NetworkOperationsLatencySeconds:     m.metricNetworkOperationsLatencySeconds,
+		collectors.NetworkOperationsErrorsTotal:        m.metricNetworkOperationsErrorsTotal,
+		collectors.NetworkTeardownsPending:             m.metricNetworkTeardownsPending,
+		collectors.PortForwardSessionsTotal:            m.metricPortForwardSessionsTotal,
+		collectors.PortForwardSessionDurationSeconds:   m.metricPortForwardSessionDurationSeconds,
+		collectors.PortForwardBytesTotal:               m.metricPortForwardBytesTotal,
+	}
+}
 
-			if err := prometheus.Register(metric); err != nil {
-				return nil, fmt.Errorf("register metric: %w", err)
-			}
-		} else {
-			logrus.Debugf("Skipping metric: %s", collector.Stripped())
-		}
+// startExporters starts exporting the registered metrics via OpenTelemetry, if
+// configured.
+func (m *Metrics) startExporters(ctx context.Context, stop chan struct{}) error {
+	if m.config.MetricsOTLPEndpoint == "" && m.config.MetricsExportFile == "" {
+		return nil
 	}
 
+	shutdown, err := opentelemetry.InitMetrics(ctx, prometheus.DefaultGatherer,
+		m.config.MetricsOTLPEndpoint, m.config.MetricsExportFile,
+		time.Duration(m.config.MetricsExportPeriod)*time.Second)
+	if err != nil {
+		return err
+	}
+
+	log.Infof(ctx, "Exporting metrics every %ds", m.config.MetricsExportPeriod)
+
+	go func() {
+		<-stop
+
+		if err := shutdown(context.Background()); err != nil {
+			log.Errorf(ctx, "Error on metrics exporters shutdown: %v", err)
+		}
+	}()
+
+	return nil
+}
+
+// createEndpoint creates a /metrics endpoint for prometheus monitoring.
+func (m *Metrics) createEndpoint() *http.ServeMux {
 	mux := &http.ServeMux{}
 	mux.Handle("/metrics", promhttp.Handler())
 
-	return mux, nil
+	return mux
 }
 
 func (m *Metrics) startEndpoint(
@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	libconfig "github.com/cri-o/cri-o/pkg/config"
 	"github.com/cri-o/cri-o/server/metrics"
 	. "github.com/cri-o/cri-o/test/framework"
 )
@@ -51,4 +52,29 @@ var _ = t.Describe("Metrics", func() {
 			Expect(res).To(BeZero())
 		})
 	})
+	t.Describe("OperationLabelsEnabled", func() {
+		It("should be disabled per default", func() {
+			// Given
+			sut := metrics.New(&libconfig.MetricsConfig{}, &libconfig.APIConfig{})
+
+			// When
+			res := sut.OperationLabelsEnabled()
+
+			// Then
+			Expect(res).To(BeFalse())
+		})
+
+		It("should be enabled with additional labels", func() {
+			// Given
+			sut := metrics.New(&libconfig.MetricsConfig{
+				MetricsOperationsLabels: []string{libconfig.OperationsLabelNamespace},
+			}, &libconfig.APIConfig{})
+
+			// When
+			res := sut.OperationLabelsEnabled()
+
+			// Then
+			Expect(res).To(BeTrue())
+		})
+	})
 })
@@ -0,0 +1,47 @@
+//go:build test
+
+// All *_inject.go files are meant to be used by tests only. Purpose of this
+// files is to provide a way to inject mocked data into the current setup.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/cri-o/cri-o/server/metrics/collectors"
+)
+
+// Samples returns the current samples of the provided collector, which carry
+// all of the provided labels.
+func (m *Metrics) Samples(collector collectors.Collector, labels map[string]string) []*dto.Metric {
+	ch := make(chan prometheus.Metric)
+
+	go func() {
+		m.metricCollectors()[collector].Collect(ch)
+		close(ch)
+	}()
+
+	samples := []*dto.Metric{}
+
+	for metric := range ch {
+		sample := &dto.Metric{}
+		if err := metric.Write(sample); err != nil {
+			continue
+		}
+
+		matches := 0
+
+		for _, label := range sample.GetLabel() {
+			if value, ok := labels[label.GetName()]; ok && value == label.GetValue() {
+				matches++
+			}
+		}
+
+		if matches == len(labels) {
+			samples = append(samples, sample)
+		}
+	}
+
+	return samples
+}
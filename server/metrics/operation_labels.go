@@ -0,0 +1,115 @@
+package metrics
+
+import (
+	"slices"
+	"sync"
+
+	libconfig "github.com/cri-o/cri-o/pkg/config"
+)
+
+// OtherLabelValue is reported for label values exceeding the configured
+// cardinality limit or missing in the allowlist.
+const OtherLabelValue = "other"
+
+// OperationLabels are the values of the optional labels of the operations
+// metrics.
+type OperationLabels struct {
+	// RuntimeHandler is the runtime handler of the pod the operation got
+	// called for.
+	RuntimeHandler string
+
+	// Namespace is the Kubernetes namespace of the pod the operation got
+	// called for.
+	Namespace string
+}
+
+// labelValues limits the cardinality of a single metrics label.
+type labelValues struct {
+	mutex     sync.Mutex
+	limit     int
+	allowlist []string
+	seen      map[string]struct{}
+}
+
+func newLabelValues(limit int, allowlist []string) *labelValues {
+	return &labelValues{
+		limit:     limit,
+		allowlist: allowlist,
+		seen:      make(map[string]struct{}),
+	}
+}
+
+// value returns the label value to be reported for the provided one.
+func (l *labelValues) value(value string) string {
+	// Operations which do not refer to a pod are always reported.
+	if value == "" {
+		return value
+	}
+
+	if len(l.allowlist) > 0 && !slices.Contains(l.allowlist, value) {
+		return OtherLabelValue
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if _, ok := l.seen[value]; ok {
+		return value
+	}
+
+	if l.limit > 0 && len(l.seen) >= l.limit {
+		return OtherLabelValue
+	}
+
+	l.seen[value] = struct{}{}
+
+	return value
+}
+
+// operationLabels limits the optional labels of the operations metrics.
+type operationLabels struct {
+	names  []string
+	values map[string]*labelValues
+}
+
+func newOperationLabels(config *libconfig.MetricsConfig) *operationLabels {
+	o := &operationLabels{
+		names:  []string{"operation"},
+		values: make(map[string]*labelValues),
+	}
+
+	for _, label := range config.MetricsOperationsLabels {
+		var allowlist []string
+		if label == libconfig.OperationsLabelNamespace {
+			allowlist = config.MetricsOperationsNamespaces
+		}
+
+		o.names = append(o.names, label)
+		o.values[label] = newLabelValues(config.MetricsOperationsLabelValuesLimit, allowlist)
+	}
+
+	return o
+}
+
+// labelValues returns the label values of the operations metrics in the
+// order of the label names.
+func (o *operationLabels) labelValues(operation string, labels OperationLabels) []string {
+	values := []string{operation}
+
+	for _, label := range o.names[1:] {
+		value := labels.Namespace
+		if label == libconfig.OperationsLabelRuntimeHandler {
+			value = labels.RuntimeHandler
+		}
+
+		values = append(values, o.values[label].value(value))
+	}
+
+	return values
+}
+
+// OperationLabelsEnabled returns true if the operations metrics have
+// additional labels, which means that the caller should provide their values.
+func (m *Metrics) OperationLabelsEnabled() bool {
+	return len(m.operationLabels.names) > 1
+}
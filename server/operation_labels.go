@@ -0,0 +1,54 @@
+package server
+
+import (
+	"context"
+
+	types "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	"github.com/cri-o/cri-o/internal/lib/sandbox"
+	"github.com/cri-o/cri-o/server/metrics"
+)
+
+// OperationLabels returns the values of the optional operations metrics labels
+// for the pod the request refers to. Requests which do not refer to a single
+// pod get empty values.
+func (s *Server) OperationLabels(req any) metrics.OperationLabels {
+	switch r := req.(type) {
+	case *types.RunPodSandboxRequest:
+		return metrics.OperationLabels{
+			RuntimeHandler: r.GetRuntimeHandler(),
+			Namespace:      r.GetConfig().GetMetadata().GetNamespace(),
+		}
+
+	case interface{ GetPodSandboxId() string }:
+		sandboxID, err := s.PodIDIndex().Get(r.GetPodSandboxId())
+		if err != nil {
+			return metrics.OperationLabels{}
+		}
+
+		return operationLabels(s.GetSandbox(sandboxID))
+
+	case interface{ GetContainerId() string }:
+		c, err := s.GetContainerFromShortID(context.Background(), r.GetContainerId())
+		if err != nil {
+			return metrics.OperationLabels{}
+		}
+
+		return operationLabels(s.GetSandbox(c.Sandbox()))
+	}
+
+	return metrics.OperationLabels{}
+}
+
+// operationLabels returns the values of the optional operations metrics labels
+// for the provided sandbox.
+func operationLabels(sb *sandbox.Sandbox) metrics.OperationLabels {
+	if sb == nil {
+		return metrics.OperationLabels{}
+	}
+
+	return metrics.OperationLabels{
+		RuntimeHandler: sb.RuntimeHandler(),
+		Namespace:      sb.Namespace(),
+	}
+}
@@ -3,7 +3,6 @@ package server
 import (
 	"context"
 	"fmt"
-	"math"
 	"os"
 	"path/filepath"
 	"strings"
@@ -12,16 +11,34 @@ import (
 	cnitypes "github.com/containernetworking/cni/pkg/types"
 	cnicurrent "github.com/containernetworking/cni/pkg/types/100"
 	"github.com/cri-o/ocicni/pkg/ocicni"
-	"k8s.io/apimachinery/pkg/api/resource"
+	json "github.com/goccy/go-json"
+	"go.opentelemetry.io/otel/trace"
 	utilnet "k8s.io/utils/net"
 
+	"github.com/cri-o/cri-o/internal/bandwidth"
+	"github.com/cri-o/cri-o/internal/config/netbackend"
+	"github.com/cri-o/cri-o/internal/hostport"
 	"github.com/cri-o/cri-o/internal/lib/sandbox"
 	"github.com/cri-o/cri-o/internal/log"
+	"github.com/cri-o/cri-o/internal/opentelemetry"
+	"github.com/cri-o/cri-o/pkg/types"
 	"github.com/cri-o/cri-o/server/metrics"
 )
 
 const (
 	cacheDir = "/var/lib/cni/results"
+
+	// networkTeardownBackoff is the initial backoff between the retries of a
+	// failed pod network teardown.
+	networkTeardownBackoff = time.Second
+
+	// networkTeardownRetryInterval is the interval in which the queued pod
+	// network teardowns are retried in the background.
+	networkTeardownRetryInterval = time.Minute
+
+	// Pod network operations for the metrics.
+	networkOperationSetup    = "setup"
+	networkOperationTeardown = "teardown"
 )
 
 // networkStart sets up the sandbox's network and returns the pod IP on success
@@ -31,13 +48,14 @@ func (s *Server) networkStart(ctx context.Context, sb *sandbox.Sandbox) (podIPs
 	defer span.End()
 
 	overallStart := time.Now()
-	// Give a network Start call a full 5 minutes, independent of the context of the request.
+	// Give a network Start call the full network_setup_timeout (5 minutes by default), independent
+	// of the context of the request.
 	// This is to prevent the CNI plugin from taking an unbounded amount of time,
 	// but to still allow a long-running sandbox creation to be cached and reused,
 	// rather than failing and recreating it.
 	// Adding on top of the specified deadline ensures this deadline will be respected, regardless of
 	// how Kubelet's runtime-request-timeout changes.
-	startTimeout := 5 * time.Minute
+	startTimeout := s.config.NetworkSetupTimeout
 	if initialDeadline, ok := ctx.Deadline(); ok {
 		startTimeout += time.Until(initialDeadline)
 	}
@@ -45,6 +63,8 @@ func (s *Server) networkStart(ctx context.Context, sb *sandbox.Sandbox) (podIPs
 	startCtx, startCancel := context.WithTimeout(context.Background(), startTimeout)
 	defer startCancel()
 
+	span.SetAttributes(opentelemetry.PodIDKey.String(sb.ID()))
+
 	if sb.HostNetwork() {
 		return nil, nil, nil
 	}
@@ -68,20 +88,20 @@ func (s *Server) networkStart(ctx context.Context, sb *sandbox.Sandbox) (podIPs
 
 	podSetUpStart := time.Now()
 
-	_, err = s.config.CNIPlugin().SetUpPodWithContext(startCtx, podNetwork)
+	err = s.config.NetworkBackend().SetUpPod(startCtx, podNetwork)
+	s.observeNetworkOperation(networkOperationSetup, podSetUpStart, err)
+
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create pod network sandbox %s(%s): %w", sb.Name(), sb.ID(), err)
 	}
 	// metric about the CNI network setup operation
-	metrics.Instance().MetricOperationsLatencySet("network_setup_pod", podSetUpStart)
+	metrics.Instance().MetricOperationsLatencySet("network_setup_pod", operationLabels(sb), podSetUpStart)
 
-	podNetworkStatus, err := s.config.CNIPlugin().GetPodNetworkStatusWithContext(startCtx, podNetwork)
+	result, err = s.config.NetworkBackend().Status(startCtx, podNetwork)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get network status for pod sandbox %s(%s): %w", sb.Name(), sb.ID(), err)
 	}
 
-	// only one cnitypes.Result is returned since newPodNetwork sets Networks list empty
-	result = podNetworkStatus[0].Result
 	log.Debugf(ctx, "CNI setup result: %v", result)
 
 	network, err := cnicurrent.GetResult(result)
@@ -89,6 +109,17 @@ func (s *Server) networkStart(ctx context.Context, sb *sandbox.Sandbox) (podIPs
 		return nil, nil, fmt.Errorf("failed to get network JSON for pod sandbox %s(%s): %w", sb.Name(), sb.ID(), err)
 	}
 
+	s.setNetworkSpanAttributes(span, network)
+
+	networkState := s.newNetworkState(network)
+
+	networkState.Bandwidth, err = s.shapeBandwidth(ctx, sb, network)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to shape bandwidth of pod sandbox %s(%s): %w", sb.Name(), sb.ID(), err)
+	}
+
+	sb.SetNetworkState(networkState)
+
 	// only do portmapping to the first IP of each IP family
 	foundIPv4 := false
 	foundIPv6 := false
@@ -96,6 +127,23 @@ func (s *Server) networkStart(ctx context.Context, sb *sandbox.Sandbox) (podIPs
 	sbID := sb.ID()
 	sbName := sb.Name()
 	sbPortMappings := sb.PortMappings()
+
+	// reject host IPs which can not be mapped to any of the pod IPs instead of
+	// silently skipping them. There is no way to emit a pod event from here,
+	// the kubelet reports the returned error as FailedCreatePodSandBox event.
+	if len(sbPortMappings) > 0 && !s.config.DisableHostPortMapping {
+		resultIPs := make([]string, 0, len(network.IPs))
+		for _, podIPConfig := range network.IPs {
+			resultIPs = append(resultIPs, podIPConfig.Address.IP.String())
+		}
+
+		if err := hostport.ValidateHostIPFamilies(resultIPs, sbPortMappings); err != nil {
+			log.Warnf(ctx, "Rejecting hostport mappings of sandbox %s(%s) with a host IP family not matching any pod IP family: %v", sbName, sbID, err)
+
+			return nil, nil, fmt.Errorf("invalid hostport mapping for sandbox %s(%s): %w", sbName, sbID, err)
+		}
+	}
+
 	// iterate over each IP and add the portmap if needed
 	for _, podIPConfig := range network.IPs {
 		ip := podIPConfig.Address.IP
@@ -129,13 +177,84 @@ func (s *Server) networkStart(ctx context.Context, sb *sandbox.Sandbox) (podIPs
 	log.Debugf(ctx, "Found POD IPs: %v", podIPs)
 
 	// metric about the whole network setup operation
-	metrics.Instance().MetricOperationsLatencySet("network_setup_overall", overallStart)
+	metrics.Instance().MetricOperationsLatencySet("network_setup_overall", operationLabels(sb), overallStart)
 
 	return podIPs, result, err
 }
 
-// getSandboxIP retrieves the IP address for the sandbox.
-func (s *Server) getSandboxIPs(ctx context.Context, sb *sandbox.Sandbox) ([]string, error) {
+// restoreHostportReservations reserves the host ports of a restored sandbox
+// for the first IP of each family, like networkStart does when adding them.
+func (s *Server) restoreHostportReservations(ctx context.Context, sb *sandbox.Sandbox, podIPs []string) {
+	sbPortMappings := sb.PortMappings()
+	if len(sbPortMappings) == 0 || sb.NetworkStopped() {
+		return
+	}
+
+	restoredFamilies := map[utilnet.IPFamily]bool{}
+
+	for _, ip := range podIPs {
+		family := utilnet.IPFamilyOfString(ip)
+		if restoredFamilies[family] {
+			continue
+		}
+
+		restoredFamilies[family] = true
+
+		if err := s.hostportManager.Restore(sb.ID(), sb.Name(), ip, sbPortMappings); err != nil {
+			log.Warnf(ctx, "Could not restore hostport reservations for sandbox %s(%s): %v", sb.Name(), sb.ID(), err)
+		}
+	}
+}
+
+// runHostportReconciler removes the installed hostport rules not belonging to
+// any known sandbox on startup and periodically afterwards, until the context
+// is done.
+func (s *Server) runHostportReconciler(ctx context.Context) {
+	s.reconcileHostports(ctx)
+
+	if s.config.HostPortReconcileInterval == 0 {
+		return
+	}
+
+	ticker := time.NewTicker(s.config.HostPortReconcileInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			s.reconcileHostports(ctx)
+		}
+	}
+}
+
+// reconcileHostports removes the installed hostport rules not belonging to any
+// known sandbox.
+func (s *Server) reconcileHostports(ctx context.Context) {
+	pods := []hostport.PodPortMappings{}
+
+	for _, sb := range s.ListSandboxes() {
+		if sb.HostNetwork() || sb.NetworkStopped() {
+			continue
+		}
+
+		pods = append(pods, hostport.PodPortMappings{
+			ID:           sb.ID(),
+			PortMappings: sb.PortMappings(),
+		})
+	}
+
+	if err := s.hostportManager.Reconcile(pods); err != nil {
+		log.Warnf(ctx, "Unable to reconcile hostport rules: %v", err)
+	}
+}
+
+// restoreSandboxNetworkState returns the persisted network state of the
+// sandbox. For sandboxes created before the state got persisted it falls back
+// to the status of the network backend and persists it.
+func (s *Server) restoreSandboxNetworkState(ctx context.Context, sb *sandbox.Sandbox) (*types.NetworkState, error) {
 	ctx, span := log.StartSpan(ctx)
 	defer span.End()
 
@@ -143,27 +262,118 @@ func (s *Server) getSandboxIPs(ctx context.Context, sb *sandbox.Sandbox) ([]stri
 		return nil, nil
 	}
 
+	state, err := sb.RestoreNetworkState()
+	if err != nil {
+		log.Warnf(ctx, "Could not read network state of sandbox %s(%s), falling back to the network backend: %v", sb.Name(), sb.ID(), err)
+	} else if state != nil {
+		return state, nil
+	}
+
 	podNetwork, err := s.newPodNetwork(ctx, sb)
 	if err != nil {
 		return nil, err
 	}
 
-	podNetworkStatus, err := s.config.CNIPlugin().GetPodNetworkStatus(podNetwork)
+	result, err := s.config.NetworkBackend().Status(ctx, podNetwork)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get network status for pod sandbox %s(%s): %w", sb.Name(), sb.ID(), err)
 	}
 
-	res, err := cnicurrent.GetResult(podNetworkStatus[0].Result)
+	network, err := cnicurrent.GetResult(result)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get network JSON for pod sandbox %s(%s): %w", sb.Name(), sb.ID(), err)
 	}
 
-	podIPs := make([]string, 0, len(res.IPs))
-	for _, podIPConfig := range res.IPs {
-		podIPs = append(podIPs, podIPConfig.Address.IP.String())
+	sb.SetNetworkState(s.newNetworkState(network))
+
+	if err := sb.SaveNetworkState(); err != nil {
+		log.Warnf(ctx, "Could not save network state of sandbox %s(%s): %v", sb.Name(), sb.ID(), err)
+	}
+
+	return sb.NetworkState(), nil
+}
+
+// newNetworkState converts the result of the network backend into the network
+// state of a sandbox.
+func (s *Server) newNetworkState(result *cnicurrent.Result) *types.NetworkState {
+	state := &types.NetworkState{
+		Backend:   s.config.NetworkBackend().Name(),
+		Network:   s.config.NetworkBackend().DefaultNetworkName(),
+		IPs:       make([]string, 0, len(result.IPs)),
+		CreatedAt: time.Now().UnixNano(),
+	}
+
+	for _, ip := range result.IPs {
+		state.IPs = append(state.IPs, ip.Address.IP.String())
+	}
+
+	for _, iface := range result.Interfaces {
+		state.Interfaces = append(state.Interfaces, types.NetworkInterface{
+			Name:    iface.Name,
+			Mac:     iface.Mac,
+			Sandbox: iface.Sandbox,
+		})
+	}
+
+	for _, route := range result.Routes {
+		networkRoute := types.NetworkRoute{Destination: route.Dst.String()}
+		if route.GW != nil {
+			networkRoute.Gateway = route.GW.String()
+		}
+
+		state.Routes = append(state.Routes, networkRoute)
+	}
+
+	if len(result.DNS.Nameservers) > 0 || result.DNS.Domain != "" || len(result.DNS.Search) > 0 || len(result.DNS.Options) > 0 {
+		state.DNS = &types.NetworkDNS{
+			Nameservers: result.DNS.Nameservers,
+			Domain:      result.DNS.Domain,
+			Search:      result.DNS.Search,
+			Options:     result.DNS.Options,
+		}
+	}
+
+	if data, err := json.Marshal(result); err == nil {
+		state.Result = data
+	}
+
+	return state
+}
+
+// shapeBandwidth applies the bandwidth limits of the sandbox annotations on
+// native shaping and returns the limits of the sandbox network, if any.
+func (s *Server) shapeBandwidth(ctx context.Context, sb *sandbox.Sandbox, result *cnicurrent.Result) (*types.NetworkBandwidth, error) {
+	limits, err := bandwidth.FromAnnotations(sb.Annotations())
+	if err != nil || limits == nil {
+		return nil, err
 	}
 
-	return podIPs, nil
+	if s.config.BandwidthShaping == bandwidth.ShapingNative {
+		// Shape the first interface inside of the pod.
+		podInterface := "eth0"
+
+		for _, iface := range result.Interfaces {
+			if iface.Sandbox != "" {
+				podInterface = iface.Name
+
+				break
+			}
+		}
+
+		if err := bandwidth.Apply(sb.ID(), sb.NetNsPath(), podInterface, limits); err != nil {
+			return nil, err
+		}
+
+		log.Infof(ctx, "Applied bandwidth limits to interface %s of pod sandbox %s(%s)", podInterface, sb.Name(), sb.ID())
+	}
+
+	return &types.NetworkBandwidth{
+		Shaping:      s.config.BandwidthShaping,
+		IngressRate:  limits.IngressRate,
+		IngressBurst: limits.IngressBurst,
+		EgressRate:   limits.EgressRate,
+		EgressBurst:  limits.EgressBurst,
+	}, nil
 }
 
 // networkStop cleans up and removes a pod's network.  It is best-effort and
@@ -172,12 +382,13 @@ func (s *Server) networkStop(ctx context.Context, sb *sandbox.Sandbox) error {
 	ctx, span := log.StartSpan(ctx)
 	defer span.End()
 
+	span.SetAttributes(opentelemetry.PodIDKey.String(sb.ID()))
+
 	if sb.HostNetwork() || sb.NetworkStopped() {
 		return nil
 	}
-	// give a network stop call 1 minutes, half of a StopPod request timeout limit
-	stopCtx, stopCancel := context.WithTimeout(ctx, 1*time.Minute)
-	defer stopCancel()
+
+	s.setNetworkSpanAttributes(span, nil)
 
 	// portMapping removal does not need the IP address
 	if err := s.hostportManager.Remove(sb.ID(), sb.PortMappings()); err != nil {
@@ -185,6 +396,13 @@ func (s *Server) networkStop(ctx context.Context, sb *sandbox.Sandbox) error {
 			sb.Name(), sb.ID(), err)
 	}
 
+	if s.config.BandwidthShaping == bandwidth.ShapingNative {
+		if err := bandwidth.Remove(sb.ID()); err != nil {
+			log.Warnf(ctx, "Failed to remove bandwidth shaping for pod sandbox %s(%s): %v",
+				sb.Name(), sb.ID(), err)
+		}
+	}
+
 	podNetwork, err := s.newPodNetwork(ctx, sb)
 	if err != nil {
 		return fmt.Errorf("failed to create pod network for sandbox %s(%s): %w", sb.Name(), sb.ID(), err)
@@ -222,13 +440,20 @@ func (s *Server) networkStop(ctx context.Context, sb *sandbox.Sandbox) error {
 	}
 
 	// Always attempt CNI teardown to prevent IP leaks, even if netns is invalid.
-	if err := s.config.CNIPlugin().TearDownPodWithContext(stopCtx, podNetwork); err != nil {
+	// Only retry it if the netns is valid, since the failure is expected otherwise.
+	retries := 0
+	if netnsValid {
+		retries = s.config.NetworkTeardownRetries
+	}
+
+	if err := s.tearDownPodNetwork(ctx, podNetwork, retries); err != nil {
 		if !netnsValid {
 			// This is expected when the network namespace is missing/invalid.
 			log.Debugf(ctx, "CNI teardown failed due to missing/invalid network namespace for pod sandbox %s(%s): %v", sb.Name(), sb.ID(), err)
 
 			// Clean up CNI result files even when NetNS is invalid.
 			s.cleanupCNIResultFiles(ctx, sb.ID())
+			s.removeNetworkState(ctx, sb)
 
 			return sb.SetNetworkStopped(ctx, true)
 		}
@@ -243,8 +468,17 @@ func (s *Server) networkStop(ctx context.Context, sb *sandbox.Sandbox) error {
 			}
 		}
 
-		// Clean up CNI result files if CNI teardown failed.
-		s.cleanupCNIResultFiles(ctx, sb.ID())
+		// Queue the teardown for being retried in the background to not leak
+		// the IPs. The CNI result files are still required for that, so only
+		// clean them up if queueing failed.
+		if queueErr := s.networkTeardownQueue.Add(&podNetwork); queueErr != nil {
+			log.Warnf(ctx, "Failed to queue network teardown for pod sandbox %s(%s): %v", sb.Name(), sb.ID(), queueErr)
+			s.cleanupCNIResultFiles(ctx, sb.ID())
+		} else {
+			log.Infof(ctx, "Queued network teardown for pod sandbox %s(%s) to be retried in the background", sb.Name(), sb.ID())
+		}
+
+		s.removeNetworkState(ctx, sb)
 
 		// Even if CNI teardown failed, mark network as stopped to prevent retry loops.
 		if setErr := sb.SetNetworkStopped(ctx, true); setErr != nil {
@@ -254,9 +488,158 @@ func (s *Server) networkStop(ctx context.Context, sb *sandbox.Sandbox) error {
 		return fmt.Errorf("network teardown failed for pod sandbox %s(%s): %w", sb.Name(), sb.ID(), err)
 	}
 
+	s.removeNetworkState(ctx, sb)
+
 	return sb.SetNetworkStopped(ctx, true)
 }
 
+// tearDownPodNetwork tears down the pod network by using the network backend.
+// Failed attempts are retried up to the provided amount of times with an
+// exponential backoff.
+func (s *Server) tearDownPodNetwork(ctx context.Context, podNetwork ocicni.PodNetwork, retries int) error {
+	backoff := networkTeardownBackoff
+
+	for attempt := 0; ; attempt++ {
+		err := s.tearDownPodNetworkOnce(ctx, podNetwork)
+		if err == nil || attempt >= retries {
+			return err
+		}
+
+		log.Warnf(ctx, "Failed to tear down network for pod sandbox %s, retrying in %v: %v", podNetwork.ID, backoff, err)
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+	}
+}
+
+// tearDownPodNetworkOnce tears down the pod network by using the network
+// backend, bounded by the network_teardown_timeout.
+func (s *Server) tearDownPodNetworkOnce(ctx context.Context, podNetwork ocicni.PodNetwork) error {
+	stopCtx, stopCancel := context.WithTimeout(ctx, s.config.NetworkTeardownTimeout)
+	defer stopCancel()
+
+	start := time.Now()
+	err := s.config.NetworkBackend().TearDownPod(stopCtx, podNetwork)
+	s.observeNetworkOperation(networkOperationTeardown, start, err)
+
+	return err
+}
+
+// runNetworkTeardownRetrier retries the queued pod network teardowns on
+// startup and periodically afterwards, until the context is done.
+func (s *Server) runNetworkTeardownRetrier(ctx context.Context) {
+	ticker := time.NewTicker(networkTeardownRetryInterval)
+	defer ticker.Stop()
+
+	for {
+		s.retryNetworkTeardowns(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+		}
+	}
+}
+
+// retryNetworkTeardowns retries the queued pod network teardowns once the
+// network plugin is ready.
+func (s *Server) retryNetworkTeardowns(ctx context.Context) {
+	if err := s.config.CNIPluginReadyOrError(); err != nil {
+		log.Debugf(ctx, "Skipping retry of queued network teardowns, network plugin not ready: %v", err)
+
+		return
+	}
+
+	pending, err := s.networkTeardownQueue.Retry(ctx, s.tearDownPodNetworkOnce)
+	if err != nil {
+		log.Warnf(ctx, "Unable to retry queued network teardowns: %v", err)
+	}
+
+	metrics.Instance().MetricNetworkTeardownsPendingSet(pending)
+}
+
+// observeNetworkOperation records the latency and the failure of a pod
+// network operation.
+func (s *Server) observeNetworkOperation(operation string, start time.Time, err error) {
+	plugin := s.networkPluginLabel()
+
+	metrics.Instance().MetricNetworkOperationsLatencyObserve(operation, plugin, start)
+
+	if err != nil {
+		metrics.Instance().MetricNetworkOperationsErrorsInc(operation, plugin)
+	}
+}
+
+// networkPluginLabel returns the plugin label of the network metrics, which
+// are the plugin types of the default CNI network or the name of the network
+// backend.
+func (s *Server) networkPluginLabel() string {
+	if plugins := s.networkPlugins(); len(plugins) > 0 {
+		return strings.Join(plugins, ",")
+	}
+
+	return s.config.NetworkBackend().Name()
+}
+
+// networkPlugins returns the plugin types of the default CNI network, if any.
+func (s *Server) networkPlugins() []string {
+	if s.config.NetworkBackend().Name() != netbackend.CNI {
+		return nil
+	}
+
+	return s.config.CNIDefaultNetworkPlugins()
+}
+
+// removeNetworkState removes the network state of a sandbox whose network got
+// torn down.
+func (s *Server) removeNetworkState(ctx context.Context, sb *sandbox.Sandbox) {
+	if err := sb.RemoveNetworkState(); err != nil {
+		log.Warnf(ctx, "Failed to remove network state of pod sandbox %s(%s): %v", sb.Name(), sb.ID(), err)
+	}
+}
+
+// setNetworkSpanAttributes adds the default CNI network, its plugin types and
+// the optional CNI result to the provided span.
+func (s *Server) setNetworkSpanAttributes(span trace.Span, result *cnicurrent.Result) {
+	if !span.IsRecording() {
+		return
+	}
+
+	networkName := s.config.NetworkBackend().DefaultNetworkName()
+	span.SetAttributes(opentelemetry.CNINetworkKey.String(networkName))
+
+	if plugins := s.networkPlugins(); len(plugins) > 0 {
+		span.SetAttributes(opentelemetry.CNIPluginsKey.StringSlice(plugins))
+	}
+
+	if result == nil {
+		return
+	}
+
+	interfaces := make([]string, 0, len(result.Interfaces))
+	for _, iface := range result.Interfaces {
+		interfaces = append(interfaces, iface.Name)
+	}
+
+	ips := make([]string, 0, len(result.IPs))
+	for _, ip := range result.IPs {
+		ips = append(ips, ip.Address.String())
+	}
+
+	span.SetAttributes(
+		opentelemetry.CNIVersionKey.String(result.CNIVersion),
+		opentelemetry.CNIInterfacesKey.StringSlice(interfaces),
+		opentelemetry.CNIIPsKey.StringSlice(ips),
+	)
+}
+
 // cleanupCNIResultFiles removes CNI result files for a given container ID.
 // This is called when CNI teardown fails to prevent stale result files from accumulating.
 func (s *Server) cleanupCNIResultFiles(ctx context.Context, containerID string) {
@@ -283,42 +666,25 @@ func (s *Server) newPodNetwork(ctx context.Context, sb *sandbox.Sandbox) (ocicni
 	_, span := log.StartSpan(ctx)
 	defer span.End()
 
-	var egress, ingress int64
-
-	if val, ok := sb.Annotations()["kubernetes.io/egress-bandwidth"]; ok {
-		egressQ, err := resource.ParseQuantity(val)
-		if err != nil {
-			return ocicni.PodNetwork{}, fmt.Errorf("failed to parse egress bandwidth: %w", err)
-		} else if iegress, isok := egressQ.AsInt64(); isok {
-			egress = iegress
-		}
-	}
-
-	if val, ok := sb.Annotations()["kubernetes.io/ingress-bandwidth"]; ok {
-		ingressQ, err := resource.ParseQuantity(val)
-		if err != nil {
-			return ocicni.PodNetwork{}, fmt.Errorf("failed to parse ingress bandwidth: %w", err)
-		} else if iingress, isok := ingressQ.AsInt64(); isok {
-			ingress = iingress
-		}
+	limits, err := bandwidth.FromAnnotations(sb.Annotations())
+	if err != nil {
+		return ocicni.PodNetwork{}, err
 	}
 
+	// The limits are applied by CRI-O itself on native shaping, so they must
+	// not be applied a second time by the CNI bandwidth plugin.
 	var bwConfig *ocicni.BandwidthConfig
 
-	if ingress > 0 || egress > 0 {
-		bwConfig = &ocicni.BandwidthConfig{}
-		if ingress > 0 {
-			bwConfig.IngressRate = uint64(ingress)
-			bwConfig.IngressBurst = math.MaxUint32*8 - 1 // 4GB burst limit
-		}
-
-		if egress > 0 {
-			bwConfig.EgressRate = uint64(egress)
-			bwConfig.EgressBurst = math.MaxUint32*8 - 1 // 4GB burst limit
+	if limits != nil && s.config.BandwidthShaping == bandwidth.ShapingCNI {
+		bwConfig = &ocicni.BandwidthConfig{
+			IngressRate:  limits.IngressRate,
+			IngressBurst: limits.IngressBurst,
+			EgressRate:   limits.EgressRate,
+			EgressBurst:  limits.EgressBurst,
 		}
 	}
 
-	network := s.config.CNIPlugin().GetDefaultNetworkName()
+	network := s.config.NetworkBackend().DefaultNetworkName()
 	podAnnotations := sb.Annotations()
 	// To address typecheck linter.
 	if podAnnotations == nil {
@@ -348,7 +714,7 @@ func (s *Server) networkGC(ctx context.Context, validPods []*sandbox.Sandbox) er
 	_, span := log.StartSpan(ctx)
 	defer span.End()
 
-	return s.config.CNIPluginGC(ctx, func() ([]*ocicni.PodNetwork, error) {
+	return s.config.NetworkBackend().GC(ctx, func() ([]*ocicni.PodNetwork, error) {
 		validPodNetworks := make([]*ocicni.PodNetwork, len(validPods))
 
 		for i := range validPods {
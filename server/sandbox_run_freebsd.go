@@ -90,6 +90,10 @@ func (s *Server) runPodSandbox(ctx context.Context, req *types.RunPodSandboxRequ
 	})
 
 	s.resourceStore.SetStageForResource(ctx, sboxName, "sandbox creating")
+	resourceCleaner.Add(ctx, "runSandbox: removing pod sandbox "+sboxName+" from the resource store", func() error {
+		s.resourceStore.Delete(sboxName)
+		return nil
+	})
 
 	var securityContext *types.LinuxSandboxSecurityContext
 	if sbox.Config().Linux != nil && sbox.Config().Linux.SecurityContext != nil {
@@ -539,6 +543,7 @@ func (s *Server) runPodSandbox(ctx context.Context, req *types.RunPodSandboxRequ
 	}
 
 	s.generateCRIEvent(ctx, sb.InfraContainer(), types.ContainerEventType_CONTAINER_CREATED_EVENT)
+	s.resourceStore.SetStageForResource(ctx, sboxName, "sandbox container start")
 	if err := s.ContainerServer.Runtime().StartContainer(ctx, container); err != nil {
 		return nil, err
 	}
@@ -552,6 +557,11 @@ func (s *Server) runPodSandbox(ctx context.Context, req *types.RunPodSandboxRequ
 	}
 	sb.AddIPs(ips)
 
+	if err := sb.SaveNetworkState(); err != nil {
+		log.Warnf(ctx, "Could not save network state of sandbox %s: %v", sboxId, err)
+	}
+
+	s.resourceStore.SetStageForResource(ctx, sboxName, "sandbox NRI hooks")
 	if err := s.nri.runPodSandbox(ctx, sb); err != nil {
 		return nil, err
 	}
@@ -28,6 +28,7 @@ import (
 	"github.com/cri-o/cri-o/internal/annotations"
 	"github.com/cri-o/cri-o/internal/config/nsmgr"
 	ctrfactory "github.com/cri-o/cri-o/internal/factory/container"
+	"github.com/cri-o/cri-o/internal/hostport"
 	"github.com/cri-o/cri-o/internal/lib/constants"
 	libsandbox "github.com/cri-o/cri-o/internal/lib/sandbox"
 	"github.com/cri-o/cri-o/internal/linklogs"
@@ -467,6 +468,12 @@ func (s *Server) runPodSandbox(ctx context.Context, req *types.RunPodSandboxRequ
 	// TODO: Pass interface instead of individual field.
 	s.resourceStore.SetStageForResource(ctx, sboxName, "sandbox creating")
 
+	resourceCleaner.Add(ctx, "runSandbox: removing pod sandbox "+sboxName+" from the resource store", func() error {
+		s.resourceStore.Delete(sboxName)
+
+		return nil
+	})
+
 	securityContext, hostNetwork := s.prepareSecurityContext(sbox)
 
 	if !hostNetwork {
@@ -636,7 +643,7 @@ func (s *Server) runPodSandbox(ctx context.Context, req *types.RunPodSandboxRequ
 		return nil, err
 	}
 
-	if err := s.setupSandboxPortMappings(sbox, g); err != nil {
+	if err := s.setupSandboxPortMappings(sbox, g, kubeAnnotations); err != nil {
 		return nil, err
 	}
 
@@ -754,6 +761,13 @@ func (s *Server) runPodSandbox(ctx context.Context, req *types.RunPodSandboxRequ
 
 	sb.AddIPs(ips)
 
+	if err := sb.SaveNetworkState(); err != nil {
+		log.Warnf(ctx, "Could not save network state of sandbox %s: %v", sboxID, err)
+	}
+
+	// TODO: Pass interface instead of individual field.
+	s.resourceStore.SetStageForResource(ctx, sboxName, "sandbox NRI hooks")
+
 	if err := s.nri.runPodSandbox(ctx, sb); err != nil {
 		return nil, err
 	}
@@ -1124,9 +1138,18 @@ func (s *Server) setupSandboxSeccomp(ctx context.Context, g *generate.Generator,
 	return seccompRef, nil
 }
 
-func (s *Server) setupSandboxPortMappings(sbox libsandbox.Builder, g *generate.Generator) error {
+func (s *Server) setupSandboxPortMappings(sbox libsandbox.Builder, g *generate.Generator, kubeAnnotations map[string]string) error {
 	portMappings := convertPortMappings(sbox.Config().GetPortMappings())
 
+	if portRanges, ok := v2.GetAnnotationValue(kubeAnnotations, v2.PortRanges); ok {
+		rangeMappings, err := hostport.ParsePortRanges(portRanges)
+		if err != nil {
+			return fmt.Errorf("parse %s annotation: %w", v2.PortRanges, err)
+		}
+
+		portMappings = append(portMappings, rangeMappings...)
+	}
+
 	portMappingsJSON, err := json.Marshal(portMappings)
 	if err != nil {
 		return err
@@ -1369,6 +1392,8 @@ func (s *Server) createAndStartInfraContainer(ctx context.Context, sb *libsandbo
 
 	s.generateCRIEvent(ctx, sb.InfraContainer(), types.ContainerEventType_CONTAINER_CREATED_EVENT)
 
+	s.resourceStore.SetStageForResource(ctx, sboxName, "sandbox container start")
+
 	if err := s.ContainerServer.Runtime().StartContainer(ctx, container); err != nil {
 		return err
 	}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/cri-o/cri-o/internal/log"
 	"github.com/cri-o/cri-o/internal/oci"
+	pkgtypes "github.com/cri-o/cri-o/pkg/types"
 )
 
 // PodSandboxStatus returns the Status of the PodSandbox.
@@ -73,7 +74,12 @@ func (s *Server) PodSandboxStatus(ctx context.Context, req *types.PodSandboxStat
 	}
 
 	if req.GetVerbose() {
-		info, err := createSandboxInfo(sb.InfraContainer())
+		var bandwidth *pkgtypes.NetworkBandwidth
+		if networkState := sb.NetworkState(); networkState != nil {
+			bandwidth = networkState.Bandwidth
+		}
+
+		info, err := createSandboxInfo(sb.InfraContainer(), bandwidth)
 		if err != nil {
 			return nil, fmt.Errorf("creating sandbox info: %w", err)
 		}
@@ -92,23 +98,27 @@ func toPodIPs(ips []string) (result []*types.PodIP) {
 	return result
 }
 
-func createSandboxInfo(c *oci.Container) (map[string]string, error) {
+func createSandboxInfo(c *oci.Container, bandwidth *pkgtypes.NetworkBandwidth) (map[string]string, error) {
 	var info any
 	if c.Spoofed() {
 		info = struct {
-			RuntimeSpec spec.Spec `json:"runtimeSpec"`
+			RuntimeSpec spec.Spec                  `json:"runtimeSpec"`
+			Bandwidth   *pkgtypes.NetworkBandwidth `json:"bandwidth,omitempty"`
 		}{
 			c.Spec(),
+			bandwidth,
 		}
 	} else {
 		info = struct {
-			Image       string    `json:"image"`
-			Pid         int       `json:"pid"`
-			RuntimeSpec spec.Spec `json:"runtimeSpec"`
+			Image       string                     `json:"image"`
+			Pid         int                        `json:"pid"`
+			RuntimeSpec spec.Spec                  `json:"runtimeSpec"`
+			Bandwidth   *pkgtypes.NetworkBandwidth `json:"bandwidth,omitempty"`
 		}{
 			c.UserRequestedImage(),
 			c.State().Pid,
 			c.Spec(),
+			bandwidth,
 		}
 	}
 
@@ -25,15 +25,19 @@ import (
 	"k8s.io/kubelet/pkg/cri/streaming"
 	kubetypes "k8s.io/kubelet/pkg/types"
 
+	"github.com/cri-o/cri-o/internal/audit"
 	"github.com/cri-o/cri-o/internal/cert"
 	"github.com/cri-o/cri-o/internal/config/seccomp"
+	"github.com/cri-o/cri-o/internal/execsession"
 	"github.com/cri-o/cri-o/internal/hostport"
 	"github.com/cri-o/cri-o/internal/lib"
 	"github.com/cri-o/cri-o/internal/lib/sandbox"
 	"github.com/cri-o/cri-o/internal/log"
+	"github.com/cri-o/cri-o/internal/netteardown"
 	nriIf "github.com/cri-o/cri-o/internal/nri"
 	"github.com/cri-o/cri-o/internal/oci"
 	"github.com/cri-o/cri-o/internal/ociartifact"
+	"github.com/cri-o/cri-o/internal/oomforensics"
 	"github.com/cri-o/cri-o/internal/resourcestore"
 	"github.com/cri-o/cri-o/internal/runtimehandlerhooks"
 	"github.com/cri-o/cri-o/internal/signals"
@@ -62,6 +66,8 @@ type StreamService struct {
 	runtimeServer       *Server // needed by Exec() endpoint
 	streamServer        streaming.Server
 	streamServerCloseCh chan struct{}
+	sessions            *execsession.Registry
+	audit               *audit.Logger
 }
 
 // Server implements the RuntimeService and ImageService.
@@ -100,6 +106,14 @@ type Server struct {
 	hooksRetriever *runtimehandlerhooks.HooksRetriever
 
 	artifactStore *ociartifact.Store
+
+	// oomForensics captures the memory state of OOM killed containers, if
+	// enabled.
+	oomForensics *oomforensics.Watcher
+
+	// networkTeardownQueue persists the failed pod network teardowns to be
+	// retried in the background.
+	networkTeardownQueue *netteardown.Queue
 }
 
 // pullArguments are used to identify a pullOperation via an input image name and
@@ -310,14 +324,35 @@ func (s *Server) restore(ctx context.Context) []storage.StorageImageID {
 
 	// Restore sandbox IPs
 	for _, sb := range s.ListSandboxes() {
-		ips, err := s.getSandboxIPs(ctx, sb)
+		state, err := s.restoreSandboxNetworkState(ctx, sb)
 		if err != nil {
 			log.Warnf(ctx, "Could not restore sandbox IP for %v: %v", sb.ID(), err)
 
 			continue
 		}
 
-		sb.AddIPs(ips)
+		if state == nil {
+			continue
+		}
+
+		sb.AddIPs(state.IPs)
+		s.restoreHostportReservations(ctx, sb, state.IPs)
+	}
+
+	// Resume capturing the OOM forensics of running containers
+	if s.oomForensics != nil {
+		containers, err := s.ContainerServer.ListContainers(func(c *oci.Container) bool {
+			return c.StateNoLock().Status == oci.ContainerStateRunning
+		})
+		if err != nil {
+			log.Warnf(ctx, "Unable to list containers to watch for OOM forensics: %v", err)
+		}
+
+		for _, c := range containers {
+			if sb := s.GetSandbox(c.Sandbox()); sb != nil {
+				s.watchOOMForensics(ctx, c, sb)
+			}
+		}
 	}
 
 	// Return a slice of images to remove, if internal_wipe is set.
@@ -334,6 +369,14 @@ func (s *Server) Shutdown(ctx context.Context) error {
 	s.config.CNIManagerShutdown()
 	s.resourceStore.Close()
 
+	if err := s.stream.audit.Close(); err != nil {
+		log.Warnf(ctx, "Unable to close stream audit log: %v", err)
+	}
+
+	if err := s.oomForensics.Close(); err != nil {
+		log.Warnf(ctx, "Unable to close OOM forensics watcher: %v", err)
+	}
+
 	if err := s.ContainerServer.Shutdown(); err != nil {
 		return err
 	}
@@ -437,7 +480,7 @@ func New(
 	if config.DisableHostPortMapping {
 		hostportManager = hostport.NewNoopHostportManager()
 	} else {
-		hostportManager, err = hostport.NewMetaHostportManager(ctx)
+		hostportManager, err = hostport.NewMetaHostportManager(ctx, config.HostPortIPv6DirectRouting)
 		if err != nil {
 			return nil, fmt.Errorf("%w (use --disable-hostport-mapping to disable HostPort handling)", err)
 		}
@@ -476,6 +519,7 @@ func New(
 		resourceStore:            resourcestore.New(),
 		hooksRetriever:           runtimehandlerhooks.NewHooksRetriever(ctx, config),
 		artifactStore:            artifactStore,
+		networkTeardownQueue:     netteardown.New(config.NetworkTeardownQueueDir),
 	}
 
 	if s.config.EnablePodEvents {
@@ -483,6 +527,13 @@ func New(
 		s.ContainerEventsChan = make(chan types.ContainerEventResponse, 1000)
 	}
 
+	if s.config.OOMForensics {
+		s.oomForensics, err = oomforensics.NewWatcher(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if err := configureMaxThreads(); err != nil {
 		return nil, err
 	}
@@ -526,6 +577,14 @@ func New(
 		streamServerConfig.StreamIdleTimeout = idleTimeout
 	}
 
+	var sessionIdleTimeout time.Duration
+	if config.StreamSessionIdleTimeout != "" {
+		sessionIdleTimeout, err = time.ParseDuration(config.StreamSessionIdleTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse stream session idle timeout: %w", err)
+		}
+	}
+
 	streamServerConfig.Addr = net.JoinHostPort(bindAddressStr, config.StreamPort)
 
 	s.stream.streamServerCloseCh = make(chan struct{})
@@ -558,6 +617,14 @@ func New(
 
 	s.stream.ctx = ctx
 	s.stream.runtimeServer = s
+	s.stream.sessions = execsession.New(sessionIdleTimeout)
+
+	s.stream.audit, err = audit.New(config.StreamAuditLog, config.StreamAuditRecordingDir, config.StreamAuditRecordingMaxSize)
+	if err != nil {
+		return nil, err
+	}
+
+	go s.stream.sessions.Run(ctx)
 
 	s.stream.streamServer, err = streaming.NewServer(streamServerConfig, s.stream)
 	if err != nil {
@@ -581,13 +648,26 @@ func New(
 	}
 	// Start the metrics server if configured to be enabled
 	if s.config.EnableMetrics {
-		if err := metrics.New(&s.config.MetricsConfig, &s.config.APIConfig).Start(ctx, s.monitorsChan); err != nil {
+		m := metrics.New(&s.config.MetricsConfig, &s.config.APIConfig)
+		if s.config.MetricsPodMetrics {
+			m.AddCollector(s.NewPrometheusCollector(s.config.IncludedPodMetrics))
+		}
+
+		if err := m.Start(ctx, s.monitorsChan); err != nil {
 			return nil, err
 		}
 	} else {
 		log.Debugf(ctx, "Metrics are disabled")
 	}
 
+	// The reconciler and the retrier record metrics, which requires them to
+	// be created beforehand.
+	if !config.DisableHostPortMapping {
+		go s.runHostportReconciler(ctx)
+	}
+
+	go s.runNetworkTeardownRetrier(ctx)
+
 	if s.config.Seccomp().IsDisabled() {
 		log.Infof(ctx, "Seccomp is disabled. Not starting notifier watcher")
 	} else if err := s.startSeccompNotifierWatcher(ctx); err != nil {
@@ -5,7 +5,15 @@
 
 package server
 
+import (
+	"context"
+
+	"github.com/cri-o/cri-o/internal/execsession"
+)
+
 // SetStorageRuntimeServer sets the runtime server for the ContainerServer.
 func (s *StreamService) SetRuntimeServer(server *Server) {
 	s.runtimeServer = server
+	s.ctx = context.Background()
+	s.sessions = execsession.New(0)
 }
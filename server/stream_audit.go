@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"k8s.io/utils/exec"
+
+	"github.com/cri-o/cri-o/internal/audit"
+	"github.com/cri-o/cri-o/internal/execsession"
+	"github.com/cri-o/cri-o/internal/oci"
+)
+
+// streamAudit tracks the audit state of a single streaming session.
+type streamAudit struct {
+	logger    *audit.Logger
+	event     *audit.Event
+	started   time.Time
+	recording *audit.Recording
+}
+
+// startStreamAudit logs the start of a streaming session for the container
+// or, if c is nil, for the pod sandbox. The returned audit is nil if auditing
+// is disabled.
+func (s *StreamService) startStreamAudit(ctx context.Context, auditType audit.Type, sessionID, client string, c *oci.Container, podSandboxID string, command []string, tty bool, port int32) *streamAudit {
+	if s.audit == nil {
+		return nil
+	}
+
+	event := &audit.Event{
+		Type:         auditType,
+		Phase:        audit.PhaseStart,
+		SessionID:    sessionID,
+		Client:       client,
+		PodSandboxID: podSandboxID,
+		Command:      command,
+		TTY:          tty,
+		Port:         port,
+	}
+
+	if c != nil {
+		event.ContainerID = c.ID()
+		event.ContainerName = c.Metadata().GetName()
+		event.PodSandboxID = c.Sandbox()
+	}
+
+	if sb := s.runtimeServer.getSandbox(ctx, event.PodSandboxID); sb != nil {
+		event.PodName = sb.Metadata().GetName()
+		event.PodNamespace = sb.Namespace()
+		event.PodUID = sb.Metadata().GetUid()
+	}
+
+	a := &streamAudit{logger: s.audit, event: event, started: time.Now()}
+	event.Time = a.started
+	s.audit.Log(ctx, event)
+
+	return a
+}
+
+// startSessionAudit logs the start of an exec or attach session.
+func (s *StreamService) startSessionAudit(ctx context.Context, auditType audit.Type, session *execsession.Session, c *oci.Container, command []string, tty bool) *streamAudit {
+	return s.startStreamAudit(ctx, auditType, session.ID(), session.Info().Client, c, "", command, tty, 0)
+}
+
+// record wraps the output stream to record the session output if enabled.
+func (a *streamAudit) record(ctx context.Context, w io.WriteCloser) io.WriteCloser {
+	if a == nil {
+		return w
+	}
+
+	w, a.recording = a.logger.Record(ctx, a.event.SessionID, w)
+
+	return w
+}
+
+// finish logs the end of the session including its duration and result. The
+// exit code is only reported if withExitCode is true.
+func (a *streamAudit) finish(ctx context.Context, err error, withExitCode bool) {
+	if a == nil {
+		return
+	}
+
+	event := *a.event
+	event.Time = time.Now()
+	event.Phase = audit.PhaseEnd
+	event.DurationSeconds = event.Time.Sub(a.started).Seconds()
+
+	var exitErr exec.ExitError
+
+	switch {
+	case err == nil:
+		if withExitCode {
+			exitCode := 0
+			event.ExitCode = &exitCode
+		}
+	case errors.As(err, &exitErr):
+		exitCode := exitErr.ExitStatus()
+		event.ExitCode = &exitCode
+	default:
+		event.Error = err.Error()
+	}
+
+	a.recording.Finish(&event)
+	a.logger.Log(ctx, &event)
+}